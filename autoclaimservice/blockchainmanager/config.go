@@ -3,6 +3,7 @@ package blockchainmanager
 import (
 	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/zkevm-bridge-service/remotesigner"
 )
 
 // Config is the configuration struct for the different environments.
@@ -12,6 +13,8 @@ type Config struct {
 	// PrivateKey defines the key store file that is going
 	// to be read in order to provide the private key to sign the claim txs
 	PrivateKey types.KeystoreFileConfig `mapstructure:"PrivateKey"`
+	// RemoteSigner signs the claim txs instead of the PrivateKey when its URL is set
+	RemoteSigner remotesigner.Config `mapstructure:"RemoteSigner"`
 	// PolygonBridgeAddress is the l2 bridge smc address
 	PolygonBridgeAddress common.Address `mapstructure:"PolygonBridgeAddress"`
 	// ClaimCompressorAddress is the l2 claim compressor smc address. If it's not set, then group claims is disabled
@@ -10,6 +10,7 @@ import (
 	"github.com/fiwallets/zkevm-bridge-service/etherman/smartcontracts/claimcompressor"
 	"github.com/fiwallets/zkevm-bridge-service/etherman/smartcontracts/polygonzkevmbridgev2"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/remotesigner"
 	zkevmtypes "github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
 	"github.com/fiwallets/go-ethereum/accounts/keystore"
@@ -68,7 +69,7 @@ func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	auth, err := GetSignerFromKeystore(ctx, ethClient, cfg.PrivateKey)
+	auth, err := getSigner(ctx, ethClient, cfg)
 	if err != nil {
 		log.Errorf("error creating signer. URL: %s. Error: %v", cfg.L2RPC, err)
 		return nil, err
@@ -88,6 +89,19 @@ func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
 	}, nil
 }
 
+// getSigner returns the transaction signer of the remote signer if it is configured, otherwise the
+// one of the keystore file.
+func getSigner(ctx context.Context, ethClient *ethclient.Client, cfg *Config) (*bind.TransactOpts, error) {
+	if cfg.RemoteSigner.URL == "" {
+		return GetSignerFromKeystore(ctx, ethClient, cfg.PrivateKey)
+	}
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return remotesigner.NewTransactOpts(ctx, cfg.RemoteSigner, chainID)
+}
+
 // GetSignerFromKeystore returns a transaction signer from the keystore file.
 func GetSignerFromKeystore(ctx context.Context, ethClient *ethclient.Client, ks zkevmtypes.KeystoreFileConfig) (*bind.TransactOpts, error) {
 	keystoreEncrypted, err := os.ReadFile(filepath.Clean(ks.Path))
@@ -21,6 +21,7 @@ BridgeURL = "http://localhost:8080"
 
 [BlockchainManager]
 PrivateKey = {Path = "./test/test.keystore", Password = "testonly"}
+RemoteSigner = {URL = "", Timeout = "10s"}
 L2RPC = "http://localhost:8123"
 PolygonBridgeAddress = "0xFe12ABaa190Ef0c8638Ee0ba9F828BF41368Ca0E"
 ClaimCompressorAddress = "0x0000000000000000000000000000000000000000"
@@ -60,7 +60,7 @@ func (bt *BridgeController) GetMerkleTreeID(networkID uint32) (uint8, error) {
 
 // AddDeposit adds deposit information to the bridge tree.
 func (bt *BridgeController) AddDeposit(ctx context.Context, deposit *etherman.Deposit, depositID uint64, dbTx pgx.Tx) error {
-	leaf := hashDeposit(deposit)
+	leaf := HashDeposit(deposit)
 	tID, err := bt.GetMerkleTreeID(deposit.NetworkID)
 	if err != nil {
 		return err
@@ -393,7 +393,8 @@ func ComputeSiblings(rollupIndex uint32, leaves [][KeyLen]byte, height uint8) ([
 	return siblings, common.BytesToHash(ns[0][0]), nil
 }
 
-func calculateRoot(leafHash common.Hash, smtProof [][KeyLen]byte, index uint, height uint8) common.Hash {
+// CalculateRoot returns the root of a tree given a leaf, its index and the siblings from the leaf to the top.
+func CalculateRoot(leafHash common.Hash, smtProof [][KeyLen]byte, index uint, height uint8) common.Hash {
 	var node [KeyLen]byte
 	copy(node[:], leafHash[:])
 
@@ -69,7 +69,7 @@ func TestLeafHash(t *testing.T) {
 				DepositCount:       uint32(ti + 1),
 				Metadata:           common.FromHex(testVector.Metadata),
 			}
-			leafHash := hashDeposit(deposit)
+			leafHash := HashDeposit(deposit)
 			assert.Equal(t, testVector.ExpectedHash[2:], hex.EncodeToString(leafHash[:]))
 		})
 	}
@@ -130,7 +130,7 @@ func TestMTAddLeaf(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, hex.EncodeToString(curRoot), testVector.CurrentRoot[2:])
 
-			leafHash := hashDeposit(deposit)
+			leafHash := HashDeposit(deposit)
 			err = mt.addLeaf(ctx, depositIDs[len(depositIDs)-1], leafHash, uint32(len(testVector.ExistingLeaves)), nil)
 			require.NoError(t, err)
 			newRoot, err := mt.getRoot(ctx, nil)
@@ -184,7 +184,7 @@ func TestMTGetProof(t *testing.T) {
 				}
 				depositID, err := store.AddDeposit(ctx, deposit, nil)
 				require.NoError(t, err)
-				leafHash := hashDeposit(deposit)
+				leafHash := HashDeposit(deposit)
 				if li == int(testVector.Index) {
 					cur = leafHash
 				}
@@ -395,7 +395,7 @@ func TestCheckMerkleProof(t *testing.T) {
 		DepositCount:       0,
 		Metadata:           []byte{},
 	}
-	leafHash := hashDeposit(deposit)
+	leafHash := HashDeposit(deposit)
 	smtProof := [][KeyLen]byte{
 		common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
 		common.HexToHash("0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5"),
@@ -430,7 +430,7 @@ func TestCheckMerkleProof(t *testing.T) {
 		common.HexToHash("0x93237c50ba75ee485f4c22adf2f741400bdf8d6a9cc7df7ecae576221665d735"),
 		common.HexToHash("0x8448818bb4ae4562849e949e17ac16e0be16688e156b5cf15e098c627c0056a9"),
 	}
-	root := calculateRoot(leafHash, smtProof, index, height)
+	root := CalculateRoot(leafHash, smtProof, index, height)
 	assert.Equal(t, expectedRoot, root)
 }
 
@@ -452,7 +452,7 @@ func TestCheckMerkleProof2(t *testing.T) {
 		DepositCount:       0,
 		Metadata:           []byte{},
 	}
-	leafBytes := hashDeposit(deposit)
+	leafBytes := HashDeposit(deposit)
 	leafHash := common.BytesToHash(leafBytes[:])
 	t.Log("leafHash: ", leafHash)
 	assert.Equal(t, expectedLeafHash, leafHash)
@@ -490,7 +490,7 @@ func TestCheckMerkleProof2(t *testing.T) {
 		common.HexToHash("0x93237c50ba75ee485f4c22adf2f741400bdf8d6a9cc7df7ecae576221665d735"),
 		common.HexToHash("0x8448818bb4ae4562849e949e17ac16e0be16688e156b5cf15e098c627c0056a9"),
 	}
-	root := calculateRoot(leafHash, smtProof, index, height)
+	root := CalculateRoot(leafHash, smtProof, index, height)
 	t.Log("root: ", root)
 	assert.Equal(t, expectedRollup1Root, root)
 
@@ -529,7 +529,7 @@ func TestCheckMerkleProof2(t *testing.T) {
 		common.HexToHash("0x93237c50ba75ee485f4c22adf2f741400bdf8d6a9cc7df7ecae576221665d735"),
 		common.HexToHash("0x8448818bb4ae4562849e949e17ac16e0be16688e156b5cf15e098c627c0056a9"),
 	}
-	root2 := calculateRoot(leafHash2, smtProof2, index, height)
+	root2 := CalculateRoot(leafHash2, smtProof2, index, height)
 	t.Log("rollupsExitRoot: ", root2)
 	assert.Equal(t, expectedRollupsTreeRoot, root2)
 }
@@ -0,0 +1,10 @@
+package pb
+
+import (
+	_ "embed"
+)
+
+// OpenAPISpec is the OpenAPI v2 document of the HTTP/REST API, generated from the proto annotations
+//
+//go:embed query.swagger.json
+var OpenAPISpec []byte
@@ -464,14 +464,32 @@ func (x *Proof) GetRollupExitRoot() string {
 	return ""
 }
 
-type CheckAPIRequest struct {
+// Bridge status message
+type BridgeStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *CheckAPIRequest) Reset() {
-	*x = CheckAPIRequest{}
+	// One of: deposited, waiting_ger, ready_for_claim, autoclaim_queued, autoclaim_compressing, autoclaim_sent, autoclaim_failed, autoclaim_rejected, claimed
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Deposit              *Deposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	DepositedAt          uint64   `protobuf:"varint,3,opt,name=deposited_at,json=depositedAt,proto3" json:"deposited_at,omitempty"`
+	ClaimTxHash          string   `protobuf:"bytes,4,opt,name=claim_tx_hash,json=claimTxHash,proto3" json:"claim_tx_hash,omitempty"`
+	ClaimedAt            uint64   `protobuf:"varint,5,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	MonitoredTxStatus    string   `protobuf:"bytes,6,opt,name=monitored_tx_status,json=monitoredTxStatus,proto3" json:"monitored_tx_status,omitempty"`
+	MonitoredTxHashes    []string `protobuf:"bytes,7,rep,name=monitored_tx_hashes,json=monitoredTxHashes,proto3" json:"monitored_tx_hashes,omitempty"`
+	MonitoredTxCreatedAt uint64   `protobuf:"varint,8,opt,name=monitored_tx_created_at,json=monitoredTxCreatedAt,proto3" json:"monitored_tx_created_at,omitempty"`
+	MonitoredTxUpdatedAt uint64   `protobuf:"varint,9,opt,name=monitored_tx_updated_at,json=monitoredTxUpdatedAt,proto3" json:"monitored_tx_updated_at,omitempty"`
+	GroupId              uint64   `protobuf:"varint,10,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupStatus          string   `protobuf:"bytes,11,opt,name=group_status,json=groupStatus,proto3" json:"group_status,omitempty"`
+	GroupTxHashes        []string `protobuf:"bytes,12,rep,name=group_tx_hashes,json=groupTxHashes,proto3" json:"group_tx_hashes,omitempty"`
+	GroupUpdatedAt       uint64   `protobuf:"varint,13,opt,name=group_updated_at,json=groupUpdatedAt,proto3" json:"group_updated_at,omitempty"`
+	// Why the deposit is not claimed by the claim tx manager, set with the autoclaim_rejected status
+	RejectedReason string `protobuf:"bytes,14,opt,name=rejected_reason,json=rejectedReason,proto3" json:"rejected_reason,omitempty"`
+}
+
+func (x *BridgeStatus) Reset() {
+	*x = BridgeStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,13 +497,13 @@ func (x *CheckAPIRequest) Reset() {
 	}
 }
 
-func (x *CheckAPIRequest) String() string {
+func (x *BridgeStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAPIRequest) ProtoMessage() {}
+func (*BridgeStatus) ProtoMessage() {}
 
-func (x *CheckAPIRequest) ProtoReflect() protoreflect.Message {
+func (x *BridgeStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,23 +515,130 @@ func (x *CheckAPIRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAPIRequest.ProtoReflect.Descriptor instead.
-func (*CheckAPIRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BridgeStatus.ProtoReflect.Descriptor instead.
+func (*BridgeStatus) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{4}
 }
 
-type GetBridgesRequest struct {
+func (x *BridgeStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BridgeStatus) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *BridgeStatus) GetDepositedAt() uint64 {
+	if x != nil {
+		return x.DepositedAt
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetClaimTxHash() string {
+	if x != nil {
+		return x.ClaimTxHash
+	}
+	return ""
+}
+
+func (x *BridgeStatus) GetClaimedAt() uint64 {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetMonitoredTxStatus() string {
+	if x != nil {
+		return x.MonitoredTxStatus
+	}
+	return ""
+}
+
+func (x *BridgeStatus) GetMonitoredTxHashes() []string {
+	if x != nil {
+		return x.MonitoredTxHashes
+	}
+	return nil
+}
+
+func (x *BridgeStatus) GetMonitoredTxCreatedAt() uint64 {
+	if x != nil {
+		return x.MonitoredTxCreatedAt
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetMonitoredTxUpdatedAt() uint64 {
+	if x != nil {
+		return x.MonitoredTxUpdatedAt
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetGroupStatus() string {
+	if x != nil {
+		return x.GroupStatus
+	}
+	return ""
+}
+
+func (x *BridgeStatus) GetGroupTxHashes() []string {
+	if x != nil {
+		return x.GroupTxHashes
+	}
+	return nil
+}
+
+func (x *BridgeStatus) GetGroupUpdatedAt() uint64 {
+	if x != nil {
+		return x.GroupUpdatedAt
+	}
+	return 0
+}
+
+func (x *BridgeStatus) GetRejectedReason() string {
+	if x != nil {
+		return x.RejectedReason
+	}
+	return ""
+}
+
+// Synchronization status of a network
+type NetworkSyncStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	Offset   uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-}
-
-func (x *GetBridgesRequest) Reset() {
-	*x = GetBridgesRequest{}
+	NetworkId           uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	LastSyncedBlock     uint64 `protobuf:"varint,2,opt,name=last_synced_block,json=lastSyncedBlock,proto3" json:"last_synced_block,omitempty"`
+	LastSyncedBlockHash string `protobuf:"bytes,3,opt,name=last_synced_block_hash,json=lastSyncedBlockHash,proto3" json:"last_synced_block_hash,omitempty"`
+	// Latest block reported by the synchronizer, 0 if it hasn't reported yet
+	ChainHead                   uint64 `protobuf:"varint,4,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
+	Lag                         uint64 `protobuf:"varint,5,opt,name=lag,proto3" json:"lag,omitempty"`
+	Synced                      bool   `protobuf:"varint,6,opt,name=synced,proto3" json:"synced,omitempty"`
+	TrustedStateSynced          bool   `protobuf:"varint,7,opt,name=trusted_state_synced,json=trustedStateSynced,proto3" json:"trusted_state_synced,omitempty"`
+	LatestGer                   string `protobuf:"bytes,8,opt,name=latest_ger,json=latestGer,proto3" json:"latest_ger,omitempty"`
+	LatestVerifiedLocalExitRoot string `protobuf:"bytes,9,opt,name=latest_verified_local_exit_root,json=latestVerifiedLocalExitRoot,proto3" json:"latest_verified_local_exit_root,omitempty"`
+	StatusUpdatedAt             uint64 `protobuf:"varint,10,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
+}
+
+func (x *NetworkSyncStatus) Reset() {
+	*x = NetworkSyncStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,13 +646,13 @@ func (x *GetBridgesRequest) Reset() {
 	}
 }
 
-func (x *GetBridgesRequest) String() string {
+func (x *NetworkSyncStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgesRequest) ProtoMessage() {}
+func (*NetworkSyncStatus) ProtoMessage() {}
 
-func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
+func (x *NetworkSyncStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -539,46 +664,93 @@ func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgesRequest.ProtoReflect.Descriptor instead.
-func (*GetBridgesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkSyncStatus.ProtoReflect.Descriptor instead.
+func (*NetworkSyncStatus) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBridgesRequest) GetDestAddr() string {
+func (x *NetworkSyncStatus) GetNetworkId() uint32 {
 	if x != nil {
-		return x.DestAddr
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *NetworkSyncStatus) GetLastSyncedBlock() uint64 {
+	if x != nil {
+		return x.LastSyncedBlock
+	}
+	return 0
+}
+
+func (x *NetworkSyncStatus) GetLastSyncedBlockHash() string {
+	if x != nil {
+		return x.LastSyncedBlockHash
 	}
 	return ""
 }
 
-func (x *GetBridgesRequest) GetOffset() uint32 {
+func (x *NetworkSyncStatus) GetChainHead() uint64 {
 	if x != nil {
-		return x.Offset
+		return x.ChainHead
 	}
 	return 0
 }
 
-func (x *GetBridgesRequest) GetLimit() uint32 {
+func (x *NetworkSyncStatus) GetLag() uint64 {
 	if x != nil {
-		return x.Limit
+		return x.Lag
 	}
 	return 0
 }
 
-type GetPendingBridgesRequest struct {
+func (x *NetworkSyncStatus) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *NetworkSyncStatus) GetTrustedStateSynced() bool {
+	if x != nil {
+		return x.TrustedStateSynced
+	}
+	return false
+}
+
+func (x *NetworkSyncStatus) GetLatestGer() string {
+	if x != nil {
+		return x.LatestGer
+	}
+	return ""
+}
+
+func (x *NetworkSyncStatus) GetLatestVerifiedLocalExitRoot() string {
+	if x != nil {
+		return x.LatestVerifiedLocalExitRoot
+	}
+	return ""
+}
+
+func (x *NetworkSyncStatus) GetStatusUpdatedAt() uint64 {
+	if x != nil {
+		return x.StatusUpdatedAt
+	}
+	return 0
+}
+
+// Deposit key message
+type DepositKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	DestNet  uint32 `protobuf:"varint,2,opt,name=dest_net,json=destNet,proto3" json:"dest_net,omitempty"`
-	LeafType uint32 `protobuf:"varint,3,opt,name=leaf_type,json=leafType,proto3" json:"leaf_type,omitempty"`
-	Offset   uint32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit    uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
 }
 
-func (x *GetPendingBridgesRequest) Reset() {
-	*x = GetPendingBridgesRequest{}
+func (x *DepositKey) Reset() {
+	*x = DepositKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,13 +758,13 @@ func (x *GetPendingBridgesRequest) Reset() {
 	}
 }
 
-func (x *GetPendingBridgesRequest) String() string {
+func (x *DepositKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPendingBridgesRequest) ProtoMessage() {}
+func (*DepositKey) ProtoMessage() {}
 
-func (x *GetPendingBridgesRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositKey) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,72 +776,122 @@ func (x *GetPendingBridgesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPendingBridgesRequest.ProtoReflect.Descriptor instead.
-func (*GetPendingBridgesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositKey.ProtoReflect.Descriptor instead.
+func (*DepositKey) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPendingBridgesRequest) GetDestAddr() string {
+func (x *DepositKey) GetNetId() uint32 {
 	if x != nil {
-		return x.DestAddr
+		return x.NetId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPendingBridgesRequest) GetDestNet() uint32 {
+func (x *DepositKey) GetDepositCnt() uint32 {
 	if x != nil {
-		return x.DestNet
+		return x.DepositCnt
 	}
 	return 0
 }
 
-func (x *GetPendingBridgesRequest) GetLeafType() uint32 {
+// Merkle Proof of a specific deposit
+type DepositProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	Proof      *Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *DepositProof) Reset() {
+	*x = DepositProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositProof) ProtoMessage() {}
+
+func (x *DepositProof) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositProof.ProtoReflect.Descriptor instead.
+func (*DepositProof) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DepositProof) GetNetId() uint32 {
 	if x != nil {
-		return x.LeafType
+		return x.NetId
 	}
 	return 0
 }
 
-func (x *GetPendingBridgesRequest) GetOffset() uint32 {
+func (x *DepositProof) GetDepositCnt() uint32 {
 	if x != nil {
-		return x.Offset
+		return x.DepositCnt
 	}
 	return 0
 }
 
-func (x *GetPendingBridgesRequest) GetLimit() uint32 {
+func (x *DepositProof) GetProof() *Proof {
 	if x != nil {
-		return x.Limit
+		return x.Proof
 	}
-	return 0
+	return nil
 }
 
-type GetProofRequest struct {
+// Claim tx data message
+type ClaimTxData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	To             string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Data           string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Method         string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	GlobalIndex    string `protobuf:"bytes,4,opt,name=global_index,json=globalIndex,proto3" json:"global_index,omitempty"`
+	GlobalExitRoot string `protobuf:"bytes,5,opt,name=global_exit_root,json=globalExitRoot,proto3" json:"global_exit_root,omitempty"`
+	MainExitRoot   string `protobuf:"bytes,6,opt,name=main_exit_root,json=mainExitRoot,proto3" json:"main_exit_root,omitempty"`
+	RollupExitRoot string `protobuf:"bytes,7,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+	Gas            uint64 `protobuf:"varint,8,opt,name=gas,proto3" json:"gas,omitempty"`
 }
 
-func (x *GetProofRequest) Reset() {
-	*x = GetProofRequest{}
+func (x *ClaimTxData) Reset() {
+	*x = ClaimTxData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[7]
+		mi := &file_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProofRequest) String() string {
+func (x *ClaimTxData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProofRequest) ProtoMessage() {}
+func (*ClaimTxData) ProtoMessage() {}
 
-func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[7]
+func (x *ClaimTxData) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,52 +902,1420 @@ func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProofRequest.ProtoReflect.Descriptor instead.
-func (*GetProofRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ClaimTxData.ProtoReflect.Descriptor instead.
+func (*ClaimTxData) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClaimTxData) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetGlobalIndex() string {
+	if x != nil {
+		return x.GlobalIndex
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetGlobalExitRoot() string {
+	if x != nil {
+		return x.GlobalExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetMainExitRoot() string {
+	if x != nil {
+		return x.MainExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimTxData) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+// Claim proof verification result
+type ClaimProofVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Level where the verification fails. One of: global_index, local_exit_root, rollup_exit_root
+	FailedLevel    string `protobuf:"bytes,2,opt,name=failed_level,json=failedLevel,proto3" json:"failed_level,omitempty"`
+	Reason         string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	LeafHash       string `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	LocalExitRoot  string `protobuf:"bytes,5,opt,name=local_exit_root,json=localExitRoot,proto3" json:"local_exit_root,omitempty"`
+	RollupExitRoot string `protobuf:"bytes,6,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+	GlobalExitRoot string `protobuf:"bytes,7,opt,name=global_exit_root,json=globalExitRoot,proto3" json:"global_exit_root,omitempty"`
+	GerKnown       bool   `protobuf:"varint,8,opt,name=ger_known,json=gerKnown,proto3" json:"ger_known,omitempty"`
+	GerAllowed     bool   `protobuf:"varint,9,opt,name=ger_allowed,json=gerAllowed,proto3" json:"ger_allowed,omitempty"`
+}
+
+func (x *ClaimProofVerification) Reset() {
+	*x = ClaimProofVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimProofVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimProofVerification) ProtoMessage() {}
+
+func (x *ClaimProofVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimProofVerification.ProtoReflect.Descriptor instead.
+func (*ClaimProofVerification) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClaimProofVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ClaimProofVerification) GetFailedLevel() string {
+	if x != nil {
+		return x.FailedLevel
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetLeafHash() string {
+	if x != nil {
+		return x.LeafHash
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetLocalExitRoot() string {
+	if x != nil {
+		return x.LocalExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetGlobalExitRoot() string {
+	if x != nil {
+		return x.GlobalExitRoot
+	}
+	return ""
+}
+
+func (x *ClaimProofVerification) GetGerKnown() bool {
+	if x != nil {
+		return x.GerKnown
+	}
+	return false
+}
+
+func (x *ClaimProofVerification) GetGerAllowed() bool {
+	if x != nil {
+		return x.GerAllowed
+	}
+	return false
+}
+
+// Cost of the claims of a day with the same token, destination network and signer
+type ClaimCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Day in UTC with the format YYYY-MM-DD
+	Day      string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	OrigNet  uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+	OrigAddr string `protobuf:"bytes,3,opt,name=orig_addr,json=origAddr,proto3" json:"orig_addr,omitempty"`
+	DestNet  uint32 `protobuf:"varint,4,opt,name=dest_net,json=destNet,proto3" json:"dest_net,omitempty"`
+	Signer   string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	Claims   uint64 `protobuf:"varint,6,opt,name=claims,proto3" json:"claims,omitempty"`
+	GasUsed  uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// L2 fee paid in wei
+	Fee string `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *ClaimCost) Reset() {
+	*x = ClaimCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCost) ProtoMessage() {}
+
+func (x *ClaimCost) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCost.ProtoReflect.Descriptor instead.
+func (*ClaimCost) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClaimCost) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *ClaimCost) GetOrigNet() uint32 {
+	if x != nil {
+		return x.OrigNet
+	}
+	return 0
+}
+
+func (x *ClaimCost) GetOrigAddr() string {
+	if x != nil {
+		return x.OrigAddr
+	}
+	return ""
+}
+
+func (x *ClaimCost) GetDestNet() uint32 {
+	if x != nil {
+		return x.DestNet
+	}
+	return 0
+}
+
+func (x *ClaimCost) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ClaimCost) GetClaims() uint64 {
+	if x != nil {
+		return x.Claims
+	}
+	return 0
+}
+
+func (x *ClaimCost) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ClaimCost) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+type CheckAPIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckAPIRequest) Reset() {
+	*x = CheckAPIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAPIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAPIRequest) ProtoMessage() {}
+
+func (x *CheckAPIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAPIRequest.ProtoReflect.Descriptor instead.
+func (*CheckAPIRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{11}
+}
+
+type GetSyncStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{12}
+}
+
+type GetBridgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	Offset   uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetBridgesRequest) Reset() {
+	*x = GetBridgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgesRequest) ProtoMessage() {}
+
+func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgesRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgesRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBridgesRequest) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *GetBridgesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetBridgesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPendingBridgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	DestNet  uint32 `protobuf:"varint,2,opt,name=dest_net,json=destNet,proto3" json:"dest_net,omitempty"`
+	LeafType uint32 `protobuf:"varint,3,opt,name=leaf_type,json=leafType,proto3" json:"leaf_type,omitempty"`
+	Offset   uint32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPendingBridgesRequest) Reset() {
+	*x = GetPendingBridgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPendingBridgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingBridgesRequest) ProtoMessage() {}
+
+func (x *GetPendingBridgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingBridgesRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingBridgesRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPendingBridgesRequest) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *GetPendingBridgesRequest) GetDestNet() uint32 {
+	if x != nil {
+		return x.DestNet
+	}
+	return 0
+}
+
+func (x *GetPendingBridgesRequest) GetLeafType() uint32 {
+	if x != nil {
+		return x.LeafType
+	}
+	return 0
+}
+
+func (x *GetPendingBridgesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPendingBridgesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *GetProofRequest) Reset() {
+	*x = GetProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofRequest) ProtoMessage() {}
+
+func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofRequest.ProtoReflect.Descriptor instead.
+func (*GetProofRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetProofRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetProofRequest) GetDepositCnt() uint32 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposits []*DepositKey `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+}
+
+func (x *GetProofsRequest) Reset() {
+	*x = GetProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofsRequest) ProtoMessage() {}
+
+func (x *GetProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofsRequest.ProtoReflect.Descriptor instead.
+func (*GetProofsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetProofsRequest) GetDeposits() []*DepositKey {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type GetProofByGERRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	Ger        string `protobuf:"bytes,3,opt,name=ger,proto3" json:"ger,omitempty"`
+}
+
+func (x *GetProofByGERRequest) Reset() {
+	*x = GetProofByGERRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofByGERRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofByGERRequest) ProtoMessage() {}
+
+func (x *GetProofByGERRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofByGERRequest.ProtoReflect.Descriptor instead.
+func (*GetProofByGERRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProofByGERRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetProofByGERRequest) GetDepositCnt() uint32 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+func (x *GetProofByGERRequest) GetGer() string {
+	if x != nil {
+		return x.Ger
+	}
+	return ""
+}
+
+type GetTokenWrappedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrigTokenAddr string `protobuf:"bytes,1,opt,name=orig_token_addr,json=origTokenAddr,proto3" json:"orig_token_addr,omitempty"`
+	OrigNet       uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+}
+
+func (x *GetTokenWrappedRequest) Reset() {
+	*x = GetTokenWrappedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenWrappedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenWrappedRequest) ProtoMessage() {}
+
+func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenWrappedRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenWrappedRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTokenWrappedRequest) GetOrigTokenAddr() string {
+	if x != nil {
+		return x.OrigTokenAddr
+	}
+	return ""
+}
+
+func (x *GetTokenWrappedRequest) GetOrigNet() uint32 {
+	if x != nil {
+		return x.OrigNet
+	}
+	return 0
+}
+
+type ListTokensWrappedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId *uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
+	OrigNet   *uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3,oneof" json:"orig_net,omitempty"`
+	Offset    uint32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     uint32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTokensWrappedRequest) Reset() {
+	*x = ListTokensWrappedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTokensWrappedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensWrappedRequest) ProtoMessage() {}
+
+func (x *ListTokensWrappedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensWrappedRequest.ProtoReflect.Descriptor instead.
+func (*ListTokensWrappedRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTokensWrappedRequest) GetNetworkId() uint32 {
+	if x != nil && x.NetworkId != nil {
+		return *x.NetworkId
+	}
+	return 0
+}
+
+func (x *ListTokensWrappedRequest) GetOrigNet() uint32 {
+	if x != nil && x.OrigNet != nil {
+		return *x.OrigNet
+	}
+	return 0
+}
+
+func (x *ListTokensWrappedRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTokensWrappedRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTokenByWrappedAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WrappedTokenAddr string `protobuf:"bytes,1,opt,name=wrapped_token_addr,json=wrappedTokenAddr,proto3" json:"wrapped_token_addr,omitempty"`
+	NetworkId        uint32 `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+}
+
+func (x *GetTokenByWrappedAddressRequest) Reset() {
+	*x = GetTokenByWrappedAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenByWrappedAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenByWrappedAddressRequest) ProtoMessage() {}
+
+func (x *GetTokenByWrappedAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenByWrappedAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenByWrappedAddressRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTokenByWrappedAddressRequest) GetWrappedTokenAddr() string {
+	if x != nil {
+		return x.WrappedTokenAddr
+	}
+	return ""
+}
+
+func (x *GetTokenByWrappedAddressRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+type GetBridgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *GetBridgeRequest) Reset() {
+	*x = GetBridgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeRequest) ProtoMessage() {}
+
+func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgeRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBridgeRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetBridgeRequest) GetDepositCnt() uint32 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetBridgeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *GetBridgeStatusRequest) Reset() {
+	*x = GetBridgeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeStatusRequest) ProtoMessage() {}
+
+func (x *GetBridgeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBridgeStatusRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetBridgeStatusRequest) GetDepositCnt() uint32 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetClaimTxDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId       uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt  uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	EstimateGas bool   `protobuf:"varint,3,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+	From        string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *GetClaimTxDataRequest) Reset() {
+	*x = GetClaimTxDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimTxDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimTxDataRequest) ProtoMessage() {}
+
+func (x *GetClaimTxDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimTxDataRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimTxDataRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetClaimTxDataRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetClaimTxDataRequest) GetDepositCnt() uint32 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+func (x *GetClaimTxDataRequest) GetEstimateGas() bool {
+	if x != nil {
+		return x.EstimateGas
+	}
+	return false
+}
+
+func (x *GetClaimTxDataRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type VerifyClaimProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The deposit is loaded from the storage if the deposit key is provided, otherwise the deposit leaf fields are used
+	DepositKey     *DepositKey `protobuf:"bytes,1,opt,name=deposit_key,json=depositKey,proto3" json:"deposit_key,omitempty"`
+	Deposit        *Deposit    `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SmtProof       []string    `protobuf:"bytes,3,rep,name=smt_proof,json=smtProof,proto3" json:"smt_proof,omitempty"`
+	SmtRollupProof []string    `protobuf:"bytes,4,rep,name=smt_rollup_proof,json=smtRollupProof,proto3" json:"smt_rollup_proof,omitempty"`
+	GlobalIndex    string      `protobuf:"bytes,5,opt,name=global_index,json=globalIndex,proto3" json:"global_index,omitempty"`
+	MainExitRoot   string      `protobuf:"bytes,6,opt,name=main_exit_root,json=mainExitRoot,proto3" json:"main_exit_root,omitempty"`
+	RollupExitRoot string      `protobuf:"bytes,7,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+}
+
+func (x *VerifyClaimProofRequest) Reset() {
+	*x = VerifyClaimProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyClaimProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyClaimProofRequest) ProtoMessage() {}
+
+func (x *VerifyClaimProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyClaimProofRequest.ProtoReflect.Descriptor instead.
+func (*VerifyClaimProofRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VerifyClaimProofRequest) GetDepositKey() *DepositKey {
+	if x != nil {
+		return x.DepositKey
+	}
+	return nil
+}
+
+func (x *VerifyClaimProofRequest) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *VerifyClaimProofRequest) GetSmtProof() []string {
+	if x != nil {
+		return x.SmtProof
+	}
+	return nil
+}
+
+func (x *VerifyClaimProofRequest) GetSmtRollupProof() []string {
+	if x != nil {
+		return x.SmtRollupProof
+	}
+	return nil
+}
+
+func (x *VerifyClaimProofRequest) GetGlobalIndex() string {
+	if x != nil {
+		return x.GlobalIndex
+	}
+	return ""
+}
+
+func (x *VerifyClaimProofRequest) GetMainExitRoot() string {
+	if x != nil {
+		return x.MainExitRoot
+	}
+	return ""
+}
+
+func (x *VerifyClaimProofRequest) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+type GetClaimsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	Offset   uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetClaimsRequest) Reset() {
+	*x = GetClaimsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsRequest) ProtoMessage() {}
+
+func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetClaimsRequest) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *GetClaimsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetClaimsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetClaimCostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Days in UTC with the format YYYY-MM-DD, both included. By default the last 30 days
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetClaimCostsRequest) Reset() {
+	*x = GetClaimCostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimCostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimCostsRequest) ProtoMessage() {}
+
+func (x *GetClaimCostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimCostsRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimCostsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetClaimCostsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetClaimCostsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type CheckAPIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+}
+
+func (x *CheckAPIResponse) Reset() {
+	*x = CheckAPIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAPIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAPIResponse) ProtoMessage() {}
+
+func (x *CheckAPIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAPIResponse.ProtoReflect.Descriptor instead.
+func (*CheckAPIResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckAPIResponse) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+type GetSyncStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Networks []*NetworkSyncStatus `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+}
+
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetSyncStatusResponse) GetNetworks() []*NetworkSyncStatus {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type GetBridgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	TotalCnt uint64     `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+}
+
+func (x *GetBridgesResponse) Reset() {
+	*x = GetBridgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgesResponse) ProtoMessage() {}
+
+func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgesResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgesResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetProofRequest) GetNetId() uint32 {
+func (x *GetBridgesResponse) GetDeposits() []*Deposit {
 	if x != nil {
-		return x.NetId
+		return x.Deposits
 	}
-	return 0
+	return nil
 }
 
-func (x *GetProofRequest) GetDepositCnt() uint32 {
+func (x *GetBridgesResponse) GetTotalCnt() uint64 {
 	if x != nil {
-		return x.DepositCnt
+		return x.TotalCnt
 	}
 	return 0
 }
 
-type GetProofByGERRequest struct {
+type GetProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
-	Ger        string `protobuf:"bytes,3,opt,name=ger,proto3" json:"ger,omitempty"`
+	Proof *Proof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *GetProofByGERRequest) Reset() {
-	*x = GetProofByGERRequest{}
+func (x *GetProofResponse) Reset() {
+	*x = GetProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[8]
+		mi := &file_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProofByGERRequest) String() string {
+func (x *GetProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProofByGERRequest) ProtoMessage() {}
+func (*GetProofResponse) ProtoMessage() {}
 
-func (x *GetProofByGERRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[8]
+func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,58 +2326,44 @@ func (x *GetProofByGERRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProofByGERRequest.ProtoReflect.Descriptor instead.
-func (*GetProofByGERRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetProofByGERRequest) GetNetId() uint32 {
-	if x != nil {
-		return x.NetId
-	}
-	return 0
-}
-
-func (x *GetProofByGERRequest) GetDepositCnt() uint32 {
-	if x != nil {
-		return x.DepositCnt
-	}
-	return 0
+// Deprecated: Use GetProofResponse.ProtoReflect.Descriptor instead.
+func (*GetProofResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetProofByGERRequest) GetGer() string {
+func (x *GetProofResponse) GetProof() *Proof {
 	if x != nil {
-		return x.Ger
+		return x.Proof
 	}
-	return ""
+	return nil
 }
 
-type GetTokenWrappedRequest struct {
+type GetProofsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrigTokenAddr string `protobuf:"bytes,1,opt,name=orig_token_addr,json=origTokenAddr,proto3" json:"orig_token_addr,omitempty"`
-	OrigNet       uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+	GlobalExitRoot string          `protobuf:"bytes,1,opt,name=global_exit_root,json=globalExitRoot,proto3" json:"global_exit_root,omitempty"`
+	Proofs         []*DepositProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
 }
 
-func (x *GetTokenWrappedRequest) Reset() {
-	*x = GetTokenWrappedRequest{}
+func (x *GetProofsResponse) Reset() {
+	*x = GetProofsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[9]
+		mi := &file_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTokenWrappedRequest) String() string {
+func (x *GetProofsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenWrappedRequest) ProtoMessage() {}
+func (*GetProofsResponse) ProtoMessage() {}
 
-func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[9]
+func (x *GetProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,51 +2374,50 @@ func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenWrappedRequest.ProtoReflect.Descriptor instead.
-func (*GetTokenWrappedRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetProofsResponse.ProtoReflect.Descriptor instead.
+func (*GetProofsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetTokenWrappedRequest) GetOrigTokenAddr() string {
+func (x *GetProofsResponse) GetGlobalExitRoot() string {
 	if x != nil {
-		return x.OrigTokenAddr
+		return x.GlobalExitRoot
 	}
 	return ""
 }
 
-func (x *GetTokenWrappedRequest) GetOrigNet() uint32 {
+func (x *GetProofsResponse) GetProofs() []*DepositProof {
 	if x != nil {
-		return x.OrigNet
+		return x.Proofs
 	}
-	return 0
+	return nil
 }
 
-type GetBridgeRequest struct {
+type GetTokenWrappedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	DepositCnt uint32 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	Tokenwrapped *TokenWrapped `protobuf:"bytes,1,opt,name=tokenwrapped,proto3" json:"tokenwrapped,omitempty"`
 }
 
-func (x *GetBridgeRequest) Reset() {
-	*x = GetBridgeRequest{}
+func (x *GetTokenWrappedResponse) Reset() {
+	*x = GetTokenWrappedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[10]
+		mi := &file_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBridgeRequest) String() string {
+func (x *GetTokenWrappedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgeRequest) ProtoMessage() {}
+func (*GetTokenWrappedResponse) ProtoMessage() {}
 
-func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[10]
+func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,52 +2428,44 @@ func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgeRequest.ProtoReflect.Descriptor instead.
-func (*GetBridgeRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetBridgeRequest) GetNetId() uint32 {
-	if x != nil {
-		return x.NetId
-	}
-	return 0
+// Deprecated: Use GetTokenWrappedResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenWrappedResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetBridgeRequest) GetDepositCnt() uint32 {
+func (x *GetTokenWrappedResponse) GetTokenwrapped() *TokenWrapped {
 	if x != nil {
-		return x.DepositCnt
+		return x.Tokenwrapped
 	}
-	return 0
+	return nil
 }
 
-type GetClaimsRequest struct {
+type ListTokensWrappedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	Offset   uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Tokenswrapped []*TokenWrapped `protobuf:"bytes,1,rep,name=tokenswrapped,proto3" json:"tokenswrapped,omitempty"`
+	TotalCnt      uint64          `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
 }
 
-func (x *GetClaimsRequest) Reset() {
-	*x = GetClaimsRequest{}
+func (x *ListTokensWrappedResponse) Reset() {
+	*x = ListTokensWrappedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[11]
+		mi := &file_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClaimsRequest) String() string {
+func (x *ListTokensWrappedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClaimsRequest) ProtoMessage() {}
+func (*ListTokensWrappedResponse) ProtoMessage() {}
 
-func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[11]
+func (x *ListTokensWrappedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,57 +2476,50 @@ func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClaimsRequest.ProtoReflect.Descriptor instead.
-func (*GetClaimsRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetClaimsRequest) GetDestAddr() string {
-	if x != nil {
-		return x.DestAddr
-	}
-	return ""
+// Deprecated: Use ListTokensWrappedResponse.ProtoReflect.Descriptor instead.
+func (*ListTokensWrappedResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetClaimsRequest) GetOffset() uint32 {
+func (x *ListTokensWrappedResponse) GetTokenswrapped() []*TokenWrapped {
 	if x != nil {
-		return x.Offset
+		return x.Tokenswrapped
 	}
-	return 0
+	return nil
 }
 
-func (x *GetClaimsRequest) GetLimit() uint32 {
+func (x *ListTokensWrappedResponse) GetTotalCnt() uint64 {
 	if x != nil {
-		return x.Limit
+		return x.TotalCnt
 	}
 	return 0
 }
 
-type CheckAPIResponse struct {
+type GetBridgeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (x *CheckAPIResponse) Reset() {
-	*x = CheckAPIResponse{}
+func (x *GetBridgeResponse) Reset() {
+	*x = GetBridgeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[12]
+		mi := &file_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckAPIResponse) String() string {
+func (x *GetBridgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAPIResponse) ProtoMessage() {}
+func (*GetBridgeResponse) ProtoMessage() {}
 
-func (x *CheckAPIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[12]
+func (x *GetBridgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,44 +2530,44 @@ func (x *CheckAPIResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAPIResponse.ProtoReflect.Descriptor instead.
-func (*CheckAPIResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetBridgeResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgeResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CheckAPIResponse) GetApi() string {
+func (x *GetBridgeResponse) GetDeposit() *Deposit {
 	if x != nil {
-		return x.Api
+		return x.Deposit
 	}
-	return ""
+	return nil
 }
 
-type GetBridgesResponse struct {
+type GetClaimsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	TotalCnt uint64     `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+	Claims   []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	TotalCnt uint64   `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
 }
 
-func (x *GetBridgesResponse) Reset() {
-	*x = GetBridgesResponse{}
+func (x *GetClaimsResponse) Reset() {
+	*x = GetClaimsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[13]
+		mi := &file_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBridgesResponse) String() string {
+func (x *GetClaimsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgesResponse) ProtoMessage() {}
+func (*GetClaimsResponse) ProtoMessage() {}
 
-func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[13]
+func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,50 +2578,50 @@ func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgesResponse.ProtoReflect.Descriptor instead.
-func (*GetBridgesResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetClaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetBridgesResponse) GetDeposits() []*Deposit {
+func (x *GetClaimsResponse) GetClaims() []*Claim {
 	if x != nil {
-		return x.Deposits
+		return x.Claims
 	}
 	return nil
 }
 
-func (x *GetBridgesResponse) GetTotalCnt() uint64 {
+func (x *GetClaimsResponse) GetTotalCnt() uint64 {
 	if x != nil {
 		return x.TotalCnt
 	}
 	return 0
 }
 
-type GetProofResponse struct {
+type GetBridgeStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof *Proof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	BridgeStatus *BridgeStatus `protobuf:"bytes,1,opt,name=bridge_status,json=bridgeStatus,proto3" json:"bridge_status,omitempty"`
 }
 
-func (x *GetProofResponse) Reset() {
-	*x = GetProofResponse{}
+func (x *GetBridgeStatusResponse) Reset() {
+	*x = GetBridgeStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[14]
+		mi := &file_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProofResponse) String() string {
+func (x *GetBridgeStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProofResponse) ProtoMessage() {}
+func (*GetBridgeStatusResponse) ProtoMessage() {}
 
-func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[14]
+func (x *GetBridgeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,43 +2632,43 @@ func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProofResponse.ProtoReflect.Descriptor instead.
-func (*GetProofResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetBridgeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetProofResponse) GetProof() *Proof {
+func (x *GetBridgeStatusResponse) GetBridgeStatus() *BridgeStatus {
 	if x != nil {
-		return x.Proof
+		return x.BridgeStatus
 	}
 	return nil
 }
 
-type GetTokenWrappedResponse struct {
+type GetClaimTxDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tokenwrapped *TokenWrapped `protobuf:"bytes,1,opt,name=tokenwrapped,proto3" json:"tokenwrapped,omitempty"`
+	ClaimTxData *ClaimTxData `protobuf:"bytes,1,opt,name=claim_tx_data,json=claimTxData,proto3" json:"claim_tx_data,omitempty"`
 }
 
-func (x *GetTokenWrappedResponse) Reset() {
-	*x = GetTokenWrappedResponse{}
+func (x *GetClaimTxDataResponse) Reset() {
+	*x = GetClaimTxDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[15]
+		mi := &file_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTokenWrappedResponse) String() string {
+func (x *GetClaimTxDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenWrappedResponse) ProtoMessage() {}
+func (*GetClaimTxDataResponse) ProtoMessage() {}
 
-func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[15]
+func (x *GetClaimTxDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,43 +2679,43 @@ func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenWrappedResponse.ProtoReflect.Descriptor instead.
-func (*GetTokenWrappedResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetClaimTxDataResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimTxDataResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetTokenWrappedResponse) GetTokenwrapped() *TokenWrapped {
+func (x *GetClaimTxDataResponse) GetClaimTxData() *ClaimTxData {
 	if x != nil {
-		return x.Tokenwrapped
+		return x.ClaimTxData
 	}
 	return nil
 }
 
-type GetBridgeResponse struct {
+type VerifyClaimProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Verification *ClaimProofVerification `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
 }
 
-func (x *GetBridgeResponse) Reset() {
-	*x = GetBridgeResponse{}
+func (x *VerifyClaimProofResponse) Reset() {
+	*x = VerifyClaimProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[16]
+		mi := &file_query_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBridgeResponse) String() string {
+func (x *VerifyClaimProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgeResponse) ProtoMessage() {}
+func (*VerifyClaimProofResponse) ProtoMessage() {}
 
-func (x *GetBridgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[16]
+func (x *VerifyClaimProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,44 +2726,43 @@ func (x *GetBridgeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgeResponse.ProtoReflect.Descriptor instead.
-func (*GetBridgeResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use VerifyClaimProofResponse.ProtoReflect.Descriptor instead.
+func (*VerifyClaimProofResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetBridgeResponse) GetDeposit() *Deposit {
+func (x *VerifyClaimProofResponse) GetVerification() *ClaimProofVerification {
 	if x != nil {
-		return x.Deposit
+		return x.Verification
 	}
 	return nil
 }
 
-type GetClaimsResponse struct {
+type GetClaimCostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Claims   []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
-	TotalCnt uint64   `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+	Costs []*ClaimCost `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
 }
 
-func (x *GetClaimsResponse) Reset() {
-	*x = GetClaimsResponse{}
+func (x *GetClaimCostsResponse) Reset() {
+	*x = GetClaimCostsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[17]
+		mi := &file_query_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClaimsResponse) String() string {
+func (x *GetClaimCostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClaimsResponse) ProtoMessage() {}
+func (*GetClaimCostsResponse) ProtoMessage() {}
 
-func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[17]
+func (x *GetClaimCostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,23 +2773,16 @@ func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClaimsResponse.ProtoReflect.Descriptor instead.
-func (*GetClaimsResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GetClaimsResponse) GetClaims() []*Claim {
-	if x != nil {
-		return x.Claims
-	}
-	return nil
+// Deprecated: Use GetClaimCostsResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimCostsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetClaimsResponse) GetTotalCnt() uint64 {
+func (x *GetClaimCostsResponse) GetCosts() []*ClaimCost {
 	if x != nil {
-		return x.TotalCnt
+		return x.Costs
 	}
-	return 0
+	return nil
 }
 
 var File_query_proto protoreflect.FileDescriptor
@@ -1310,136 +2862,418 @@ var file_query_proto_rawDesc = []byte{
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x69, 0x74,
 	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x65,
 	0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x11,
-	0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52,
+	0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xc1,
+	0x04, 0x0a, 0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x9f, 0x03, 0x0a, 0x11, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x47,
+	0x65, 0x72, 0x12, 0x44, 0x0a, 0x1f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x0c, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xf8, 0x01, 0x0a, 0x0b, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x69,
+	0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x67, 0x61, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x16, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78,
+	0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x65,
+	0x72, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67,
+	0x65, 0x72, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x65, 0x72, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x09, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67,
+	0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67,
+	0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x65, 0x61,
+	0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x45,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x42, 0x79, 0x47, 0x45, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69,
+	0x67, 0x4e, 0x65, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x22,
+	0x6e, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x79, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x86, 0x01,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xb9, 0x02, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6d, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6d, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6d, 0x74, 0x5f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x6d, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x69, 0x6e,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x22, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41,
 	0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
 	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x9d, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64,
-	0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x47, 0x45, 0x52, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x72, 0x22, 0x5b,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x24, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
-	0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x22, 0x61, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x22,
-	0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x56, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
-	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63,
-	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63,
-	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6e, 0x74, 0x32, 0xbd, 0x06, 0x0a, 0x0d, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49,
-	0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x06, 0x12, 0x04, 0x2f, 0x61, 0x70, 0x69, 0x12, 0x67, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d,
-	0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x2e, 0x62,
+	0x74, 0x22, 0x3a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x24, 0x0a,
+	0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x61, 0x70, 0x69, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x22, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x45, 0x78, 0x69, 0x74,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x56, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52,
+	0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x22, 0x77, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x54, 0x78, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x61, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x32, 0xda, 0x0d, 0x0a,
+	0x0d, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51,
+	0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x0c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x06, 0x12, 0x04, 0x2f, 0x61, 0x70,
+	0x69, 0x12, 0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
+	0x73, 0x79, 0x6e, 0x63, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x7d, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
 	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
-	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6b, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x47, 0x45, 0x52, 0x12, 0x1f, 0x2e,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x12, 0x0d, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x61, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1b, 0x2e,
 	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x42, 0x79, 0x47, 0x45, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x2d, 0x62, 0x79, 0x2d, 0x67, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x12, 0x6b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42,
+	0x79, 0x47, 0x45, 0x52, 0x12, 0x1f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x47, 0x45, 0x52, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x62, 0x79, 0x2d, 0x67, 0x65, 0x72,
+	0x12, 0x57, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x1b, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09,
+	0x12, 0x07, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x63, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x12, 0x63, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12,
-	0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x15, 0x12, 0x13, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x6f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
-	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x78, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x23, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
-	0x12, 0x10, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x73, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x48, 0x65, 0x72, 0x6d, 0x65, 0x7a,
-	0x2f, 0x7a, 0x6b, 0x65, 0x76, 0x6d, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x74, 0x72, 0x65, 0x65,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x6f,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x76, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x79, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x79, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2d, 0x62, 0x79, 0x2d, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x2d,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x78, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x12, 0x23, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
+	0x10, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x73, 0x12, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x6d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54,
+	0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x12, 0x0e, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2d, 0x74, 0x78, 0x2d, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x7b, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x2d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x2d, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x2f, 0x7a, 0x6b, 0x65, 0x76, 0x6d, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x74, 0x72, 0x65,
+	0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1454,54 +3288,104 @@ func file_query_proto_rawDescGZIP() []byte {
 	return file_query_proto_rawDescData
 }
 
-var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_query_proto_goTypes = []interface{}{
-	(*TokenWrapped)(nil),             // 0: bridge.v1.TokenWrapped
-	(*Deposit)(nil),                  // 1: bridge.v1.Deposit
-	(*Claim)(nil),                    // 2: bridge.v1.Claim
-	(*Proof)(nil),                    // 3: bridge.v1.Proof
-	(*CheckAPIRequest)(nil),          // 4: bridge.v1.CheckAPIRequest
-	(*GetBridgesRequest)(nil),        // 5: bridge.v1.GetBridgesRequest
-	(*GetPendingBridgesRequest)(nil), // 6: bridge.v1.GetPendingBridgesRequest
-	(*GetProofRequest)(nil),          // 7: bridge.v1.GetProofRequest
-	(*GetProofByGERRequest)(nil),     // 8: bridge.v1.GetProofByGERRequest
-	(*GetTokenWrappedRequest)(nil),   // 9: bridge.v1.GetTokenWrappedRequest
-	(*GetBridgeRequest)(nil),         // 10: bridge.v1.GetBridgeRequest
-	(*GetClaimsRequest)(nil),         // 11: bridge.v1.GetClaimsRequest
-	(*CheckAPIResponse)(nil),         // 12: bridge.v1.CheckAPIResponse
-	(*GetBridgesResponse)(nil),       // 13: bridge.v1.GetBridgesResponse
-	(*GetProofResponse)(nil),         // 14: bridge.v1.GetProofResponse
-	(*GetTokenWrappedResponse)(nil),  // 15: bridge.v1.GetTokenWrappedResponse
-	(*GetBridgeResponse)(nil),        // 16: bridge.v1.GetBridgeResponse
-	(*GetClaimsResponse)(nil),        // 17: bridge.v1.GetClaimsResponse
+	(*TokenWrapped)(nil),                    // 0: bridge.v1.TokenWrapped
+	(*Deposit)(nil),                         // 1: bridge.v1.Deposit
+	(*Claim)(nil),                           // 2: bridge.v1.Claim
+	(*Proof)(nil),                           // 3: bridge.v1.Proof
+	(*BridgeStatus)(nil),                    // 4: bridge.v1.BridgeStatus
+	(*NetworkSyncStatus)(nil),               // 5: bridge.v1.NetworkSyncStatus
+	(*DepositKey)(nil),                      // 6: bridge.v1.DepositKey
+	(*DepositProof)(nil),                    // 7: bridge.v1.DepositProof
+	(*ClaimTxData)(nil),                     // 8: bridge.v1.ClaimTxData
+	(*ClaimProofVerification)(nil),          // 9: bridge.v1.ClaimProofVerification
+	(*ClaimCost)(nil),                       // 10: bridge.v1.ClaimCost
+	(*CheckAPIRequest)(nil),                 // 11: bridge.v1.CheckAPIRequest
+	(*GetSyncStatusRequest)(nil),            // 12: bridge.v1.GetSyncStatusRequest
+	(*GetBridgesRequest)(nil),               // 13: bridge.v1.GetBridgesRequest
+	(*GetPendingBridgesRequest)(nil),        // 14: bridge.v1.GetPendingBridgesRequest
+	(*GetProofRequest)(nil),                 // 15: bridge.v1.GetProofRequest
+	(*GetProofsRequest)(nil),                // 16: bridge.v1.GetProofsRequest
+	(*GetProofByGERRequest)(nil),            // 17: bridge.v1.GetProofByGERRequest
+	(*GetTokenWrappedRequest)(nil),          // 18: bridge.v1.GetTokenWrappedRequest
+	(*ListTokensWrappedRequest)(nil),        // 19: bridge.v1.ListTokensWrappedRequest
+	(*GetTokenByWrappedAddressRequest)(nil), // 20: bridge.v1.GetTokenByWrappedAddressRequest
+	(*GetBridgeRequest)(nil),                // 21: bridge.v1.GetBridgeRequest
+	(*GetBridgeStatusRequest)(nil),          // 22: bridge.v1.GetBridgeStatusRequest
+	(*GetClaimTxDataRequest)(nil),           // 23: bridge.v1.GetClaimTxDataRequest
+	(*VerifyClaimProofRequest)(nil),         // 24: bridge.v1.VerifyClaimProofRequest
+	(*GetClaimsRequest)(nil),                // 25: bridge.v1.GetClaimsRequest
+	(*GetClaimCostsRequest)(nil),            // 26: bridge.v1.GetClaimCostsRequest
+	(*CheckAPIResponse)(nil),                // 27: bridge.v1.CheckAPIResponse
+	(*GetSyncStatusResponse)(nil),           // 28: bridge.v1.GetSyncStatusResponse
+	(*GetBridgesResponse)(nil),              // 29: bridge.v1.GetBridgesResponse
+	(*GetProofResponse)(nil),                // 30: bridge.v1.GetProofResponse
+	(*GetProofsResponse)(nil),               // 31: bridge.v1.GetProofsResponse
+	(*GetTokenWrappedResponse)(nil),         // 32: bridge.v1.GetTokenWrappedResponse
+	(*ListTokensWrappedResponse)(nil),       // 33: bridge.v1.ListTokensWrappedResponse
+	(*GetBridgeResponse)(nil),               // 34: bridge.v1.GetBridgeResponse
+	(*GetClaimsResponse)(nil),               // 35: bridge.v1.GetClaimsResponse
+	(*GetBridgeStatusResponse)(nil),         // 36: bridge.v1.GetBridgeStatusResponse
+	(*GetClaimTxDataResponse)(nil),          // 37: bridge.v1.GetClaimTxDataResponse
+	(*VerifyClaimProofResponse)(nil),        // 38: bridge.v1.VerifyClaimProofResponse
+	(*GetClaimCostsResponse)(nil),           // 39: bridge.v1.GetClaimCostsResponse
 }
 var file_query_proto_depIdxs = []int32{
-	1,  // 0: bridge.v1.GetBridgesResponse.deposits:type_name -> bridge.v1.Deposit
-	3,  // 1: bridge.v1.GetProofResponse.proof:type_name -> bridge.v1.Proof
-	0,  // 2: bridge.v1.GetTokenWrappedResponse.tokenwrapped:type_name -> bridge.v1.TokenWrapped
-	1,  // 3: bridge.v1.GetBridgeResponse.deposit:type_name -> bridge.v1.Deposit
-	2,  // 4: bridge.v1.GetClaimsResponse.claims:type_name -> bridge.v1.Claim
-	4,  // 5: bridge.v1.BridgeService.CheckAPI:input_type -> bridge.v1.CheckAPIRequest
-	5,  // 6: bridge.v1.BridgeService.GetBridges:input_type -> bridge.v1.GetBridgesRequest
-	7,  // 7: bridge.v1.BridgeService.GetProof:input_type -> bridge.v1.GetProofRequest
-	8,  // 8: bridge.v1.BridgeService.GetProofByGER:input_type -> bridge.v1.GetProofByGERRequest
-	10, // 9: bridge.v1.BridgeService.GetBridge:input_type -> bridge.v1.GetBridgeRequest
-	11, // 10: bridge.v1.BridgeService.GetClaims:input_type -> bridge.v1.GetClaimsRequest
-	9,  // 11: bridge.v1.BridgeService.GetTokenWrapped:input_type -> bridge.v1.GetTokenWrappedRequest
-	6,  // 12: bridge.v1.BridgeService.GetPendingBridgesToClaim:input_type -> bridge.v1.GetPendingBridgesRequest
-	12, // 13: bridge.v1.BridgeService.CheckAPI:output_type -> bridge.v1.CheckAPIResponse
-	13, // 14: bridge.v1.BridgeService.GetBridges:output_type -> bridge.v1.GetBridgesResponse
-	14, // 15: bridge.v1.BridgeService.GetProof:output_type -> bridge.v1.GetProofResponse
-	14, // 16: bridge.v1.BridgeService.GetProofByGER:output_type -> bridge.v1.GetProofResponse
-	16, // 17: bridge.v1.BridgeService.GetBridge:output_type -> bridge.v1.GetBridgeResponse
-	17, // 18: bridge.v1.BridgeService.GetClaims:output_type -> bridge.v1.GetClaimsResponse
-	15, // 19: bridge.v1.BridgeService.GetTokenWrapped:output_type -> bridge.v1.GetTokenWrappedResponse
-	13, // 20: bridge.v1.BridgeService.GetPendingBridgesToClaim:output_type -> bridge.v1.GetBridgesResponse
-	13, // [13:21] is the sub-list for method output_type
-	5,  // [5:13] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: bridge.v1.BridgeStatus.deposit:type_name -> bridge.v1.Deposit
+	3,  // 1: bridge.v1.DepositProof.proof:type_name -> bridge.v1.Proof
+	6,  // 2: bridge.v1.GetProofsRequest.deposits:type_name -> bridge.v1.DepositKey
+	6,  // 3: bridge.v1.VerifyClaimProofRequest.deposit_key:type_name -> bridge.v1.DepositKey
+	1,  // 4: bridge.v1.VerifyClaimProofRequest.deposit:type_name -> bridge.v1.Deposit
+	5,  // 5: bridge.v1.GetSyncStatusResponse.networks:type_name -> bridge.v1.NetworkSyncStatus
+	1,  // 6: bridge.v1.GetBridgesResponse.deposits:type_name -> bridge.v1.Deposit
+	3,  // 7: bridge.v1.GetProofResponse.proof:type_name -> bridge.v1.Proof
+	7,  // 8: bridge.v1.GetProofsResponse.proofs:type_name -> bridge.v1.DepositProof
+	0,  // 9: bridge.v1.GetTokenWrappedResponse.tokenwrapped:type_name -> bridge.v1.TokenWrapped
+	0,  // 10: bridge.v1.ListTokensWrappedResponse.tokenswrapped:type_name -> bridge.v1.TokenWrapped
+	1,  // 11: bridge.v1.GetBridgeResponse.deposit:type_name -> bridge.v1.Deposit
+	2,  // 12: bridge.v1.GetClaimsResponse.claims:type_name -> bridge.v1.Claim
+	4,  // 13: bridge.v1.GetBridgeStatusResponse.bridge_status:type_name -> bridge.v1.BridgeStatus
+	8,  // 14: bridge.v1.GetClaimTxDataResponse.claim_tx_data:type_name -> bridge.v1.ClaimTxData
+	9,  // 15: bridge.v1.VerifyClaimProofResponse.verification:type_name -> bridge.v1.ClaimProofVerification
+	10, // 16: bridge.v1.GetClaimCostsResponse.costs:type_name -> bridge.v1.ClaimCost
+	11, // 17: bridge.v1.BridgeService.CheckAPI:input_type -> bridge.v1.CheckAPIRequest
+	12, // 18: bridge.v1.BridgeService.GetSyncStatus:input_type -> bridge.v1.GetSyncStatusRequest
+	13, // 19: bridge.v1.BridgeService.GetBridges:input_type -> bridge.v1.GetBridgesRequest
+	15, // 20: bridge.v1.BridgeService.GetProof:input_type -> bridge.v1.GetProofRequest
+	16, // 21: bridge.v1.BridgeService.GetProofs:input_type -> bridge.v1.GetProofsRequest
+	17, // 22: bridge.v1.BridgeService.GetProofByGER:input_type -> bridge.v1.GetProofByGERRequest
+	21, // 23: bridge.v1.BridgeService.GetBridge:input_type -> bridge.v1.GetBridgeRequest
+	25, // 24: bridge.v1.BridgeService.GetClaims:input_type -> bridge.v1.GetClaimsRequest
+	18, // 25: bridge.v1.BridgeService.GetTokenWrapped:input_type -> bridge.v1.GetTokenWrappedRequest
+	19, // 26: bridge.v1.BridgeService.ListTokensWrapped:input_type -> bridge.v1.ListTokensWrappedRequest
+	20, // 27: bridge.v1.BridgeService.GetTokenByWrappedAddress:input_type -> bridge.v1.GetTokenByWrappedAddressRequest
+	14, // 28: bridge.v1.BridgeService.GetPendingBridgesToClaim:input_type -> bridge.v1.GetPendingBridgesRequest
+	22, // 29: bridge.v1.BridgeService.GetBridgeStatus:input_type -> bridge.v1.GetBridgeStatusRequest
+	23, // 30: bridge.v1.BridgeService.GetClaimTxData:input_type -> bridge.v1.GetClaimTxDataRequest
+	24, // 31: bridge.v1.BridgeService.VerifyClaimProof:input_type -> bridge.v1.VerifyClaimProofRequest
+	26, // 32: bridge.v1.BridgeService.GetClaimCosts:input_type -> bridge.v1.GetClaimCostsRequest
+	27, // 33: bridge.v1.BridgeService.CheckAPI:output_type -> bridge.v1.CheckAPIResponse
+	28, // 34: bridge.v1.BridgeService.GetSyncStatus:output_type -> bridge.v1.GetSyncStatusResponse
+	29, // 35: bridge.v1.BridgeService.GetBridges:output_type -> bridge.v1.GetBridgesResponse
+	30, // 36: bridge.v1.BridgeService.GetProof:output_type -> bridge.v1.GetProofResponse
+	31, // 37: bridge.v1.BridgeService.GetProofs:output_type -> bridge.v1.GetProofsResponse
+	30, // 38: bridge.v1.BridgeService.GetProofByGER:output_type -> bridge.v1.GetProofResponse
+	34, // 39: bridge.v1.BridgeService.GetBridge:output_type -> bridge.v1.GetBridgeResponse
+	35, // 40: bridge.v1.BridgeService.GetClaims:output_type -> bridge.v1.GetClaimsResponse
+	32, // 41: bridge.v1.BridgeService.GetTokenWrapped:output_type -> bridge.v1.GetTokenWrappedResponse
+	33, // 42: bridge.v1.BridgeService.ListTokensWrapped:output_type -> bridge.v1.ListTokensWrappedResponse
+	32, // 43: bridge.v1.BridgeService.GetTokenByWrappedAddress:output_type -> bridge.v1.GetTokenWrappedResponse
+	29, // 44: bridge.v1.BridgeService.GetPendingBridgesToClaim:output_type -> bridge.v1.GetBridgesResponse
+	36, // 45: bridge.v1.BridgeService.GetBridgeStatus:output_type -> bridge.v1.GetBridgeStatusResponse
+	37, // 46: bridge.v1.BridgeService.GetClaimTxData:output_type -> bridge.v1.GetClaimTxDataResponse
+	38, // 47: bridge.v1.BridgeService.VerifyClaimProof:output_type -> bridge.v1.VerifyClaimProofResponse
+	39, // 48: bridge.v1.BridgeService.GetClaimCosts:output_type -> bridge.v1.GetClaimCostsResponse
+	33, // [33:49] is the sub-list for method output_type
+	17, // [17:33] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_query_proto_init() }
@@ -1559,7 +3443,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAPIRequest); i {
+			switch v := v.(*BridgeStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +3455,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgesRequest); i {
+			switch v := v.(*NetworkSyncStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,7 +3467,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPendingBridgesRequest); i {
+			switch v := v.(*DepositKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +3479,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProofRequest); i {
+			switch v := v.(*DepositProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +3491,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProofByGERRequest); i {
+			switch v := v.(*ClaimTxData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +3503,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenWrappedRequest); i {
+			switch v := v.(*ClaimProofVerification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +3515,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgeRequest); i {
+			switch v := v.(*ClaimCost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +3527,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClaimsRequest); i {
+			switch v := v.(*CheckAPIRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +3539,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAPIResponse); i {
+			switch v := v.(*GetSyncStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +3551,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgesResponse); i {
+			switch v := v.(*GetBridgesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +3563,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProofResponse); i {
+			switch v := v.(*GetPendingBridgesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +3575,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenWrappedResponse); i {
+			switch v := v.(*GetProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,7 +3587,7 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgeResponse); i {
+			switch v := v.(*GetProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,6 +3599,222 @@ func file_query_proto_init() {
 			}
 		}
 		file_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofByGERRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenWrappedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokensWrappedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenByWrappedAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimTxDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyClaimProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimCostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenWrappedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokensWrappedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetClaimsResponse); i {
 			case 0:
 				return &v.state
@@ -1726,14 +3826,63 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
+		file_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimTxDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyClaimProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimCostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_query_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,13 +23,21 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	BridgeService_CheckAPI_FullMethodName                 = "/bridge.v1.BridgeService/CheckAPI"
+	BridgeService_GetSyncStatus_FullMethodName            = "/bridge.v1.BridgeService/GetSyncStatus"
 	BridgeService_GetBridges_FullMethodName               = "/bridge.v1.BridgeService/GetBridges"
 	BridgeService_GetProof_FullMethodName                 = "/bridge.v1.BridgeService/GetProof"
+	BridgeService_GetProofs_FullMethodName                = "/bridge.v1.BridgeService/GetProofs"
 	BridgeService_GetProofByGER_FullMethodName            = "/bridge.v1.BridgeService/GetProofByGER"
 	BridgeService_GetBridge_FullMethodName                = "/bridge.v1.BridgeService/GetBridge"
 	BridgeService_GetClaims_FullMethodName                = "/bridge.v1.BridgeService/GetClaims"
 	BridgeService_GetTokenWrapped_FullMethodName          = "/bridge.v1.BridgeService/GetTokenWrapped"
+	BridgeService_ListTokensWrapped_FullMethodName        = "/bridge.v1.BridgeService/ListTokensWrapped"
+	BridgeService_GetTokenByWrappedAddress_FullMethodName = "/bridge.v1.BridgeService/GetTokenByWrappedAddress"
 	BridgeService_GetPendingBridgesToClaim_FullMethodName = "/bridge.v1.BridgeService/GetPendingBridgesToClaim"
+	BridgeService_GetBridgeStatus_FullMethodName          = "/bridge.v1.BridgeService/GetBridgeStatus"
+	BridgeService_GetClaimTxData_FullMethodName           = "/bridge.v1.BridgeService/GetClaimTxData"
+	BridgeService_VerifyClaimProof_FullMethodName         = "/bridge.v1.BridgeService/VerifyClaimProof"
+	BridgeService_GetClaimCosts_FullMethodName            = "/bridge.v1.BridgeService/GetClaimCosts"
 )
 
 // BridgeServiceClient is the client API for BridgeService service.
@@ -39,10 +47,14 @@ type BridgeServiceClient interface {
 	// Getters
 	// / Get api version
 	CheckAPI(ctx context.Context, in *CheckAPIRequest, opts ...grpc.CallOption) (*CheckAPIResponse, error)
+	// / Get the synchronization status of every network
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
 	// / Get bridges for the destination address both in L1 and L2
 	GetBridges(ctx context.Context, in *GetBridgesRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error)
 	// / Get the merkle proof for the specific deposit
 	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	// / Get the merkle proofs for several deposits, all of them built against the same GER
+	GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error)
 	// / Get the merkle proof for the specific deposit and GER
 	GetProofByGER(ctx context.Context, in *GetProofByGERRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
 	// / Get the specific deposit
@@ -51,8 +63,20 @@ type BridgeServiceClient interface {
 	GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error)
 	// / Get token wrapped for the specific smart contract address both in L1 and L2
 	GetTokenWrapped(ctx context.Context, in *GetTokenWrappedRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
+	// / List the wrapped tokens, optionally filtered by the network where they were created and the original network
+	ListTokensWrapped(ctx context.Context, in *ListTokensWrappedRequest, opts ...grpc.CallOption) (*ListTokensWrappedResponse, error)
+	// / Get the token wrapped for the specific wrapped token address in the network where it was created
+	GetTokenByWrappedAddress(ctx context.Context, in *GetTokenByWrappedAddressRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
 	// / Get pending bridges to claim by the destination address, destination network and leaf type in L1 and L2's
 	GetPendingBridgesToClaim(ctx context.Context, in *GetPendingBridgesRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error)
+	// / Get the lifecycle status of the specific deposit, from the deposit to the claim in the destination network
+	GetBridgeStatus(ctx context.Context, in *GetBridgeStatusRequest, opts ...grpc.CallOption) (*GetBridgeStatusResponse, error)
+	// / Get the calldata of the claim tx for the specific deposit, ready to be signed and sent to the destination network
+	GetClaimTxData(ctx context.Context, in *GetClaimTxDataRequest, opts ...grpc.CallOption) (*GetClaimTxDataResponse, error)
+	// / Verify a claim proof recomputing the local, rollup and global exit roots
+	VerifyClaimProof(ctx context.Context, in *VerifyClaimProofRequest, opts ...grpc.CallOption) (*VerifyClaimProofResponse, error)
+	// / Get the cost of the claims sent by the claim tx manager per day, token, destination network and signer
+	GetClaimCosts(ctx context.Context, in *GetClaimCostsRequest, opts ...grpc.CallOption) (*GetClaimCostsResponse, error)
 }
 
 type bridgeServiceClient struct {
@@ -72,6 +96,15 @@ func (c *bridgeServiceClient) CheckAPI(ctx context.Context, in *CheckAPIRequest,
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetSyncStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetBridges(ctx context.Context, in *GetBridgesRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error) {
 	out := new(GetBridgesResponse)
 	err := c.cc.Invoke(ctx, BridgeService_GetBridges_FullMethodName, in, out, opts...)
@@ -90,6 +123,15 @@ func (c *bridgeServiceClient) GetProof(ctx context.Context, in *GetProofRequest,
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error) {
+	out := new(GetProofsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetProofs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetProofByGER(ctx context.Context, in *GetProofByGERRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
 	out := new(GetProofResponse)
 	err := c.cc.Invoke(ctx, BridgeService_GetProofByGER_FullMethodName, in, out, opts...)
@@ -126,6 +168,24 @@ func (c *bridgeServiceClient) GetTokenWrapped(ctx context.Context, in *GetTokenW
 	return out, nil
 }
 
+func (c *bridgeServiceClient) ListTokensWrapped(ctx context.Context, in *ListTokensWrappedRequest, opts ...grpc.CallOption) (*ListTokensWrappedResponse, error) {
+	out := new(ListTokensWrappedResponse)
+	err := c.cc.Invoke(ctx, BridgeService_ListTokensWrapped_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetTokenByWrappedAddress(ctx context.Context, in *GetTokenByWrappedAddressRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error) {
+	out := new(GetTokenWrappedResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetTokenByWrappedAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetPendingBridgesToClaim(ctx context.Context, in *GetPendingBridgesRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error) {
 	out := new(GetBridgesResponse)
 	err := c.cc.Invoke(ctx, BridgeService_GetPendingBridgesToClaim_FullMethodName, in, out, opts...)
@@ -135,6 +195,42 @@ func (c *bridgeServiceClient) GetPendingBridgesToClaim(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetBridgeStatus(ctx context.Context, in *GetBridgeStatusRequest, opts ...grpc.CallOption) (*GetBridgeStatusResponse, error) {
+	out := new(GetBridgeStatusResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetBridgeStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetClaimTxData(ctx context.Context, in *GetClaimTxDataRequest, opts ...grpc.CallOption) (*GetClaimTxDataResponse, error) {
+	out := new(GetClaimTxDataResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetClaimTxData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) VerifyClaimProof(ctx context.Context, in *VerifyClaimProofRequest, opts ...grpc.CallOption) (*VerifyClaimProofResponse, error) {
+	out := new(VerifyClaimProofResponse)
+	err := c.cc.Invoke(ctx, BridgeService_VerifyClaimProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetClaimCosts(ctx context.Context, in *GetClaimCostsRequest, opts ...grpc.CallOption) (*GetClaimCostsResponse, error) {
+	out := new(GetClaimCostsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetClaimCosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeServiceServer is the server API for BridgeService service.
 // All implementations must embed UnimplementedBridgeServiceServer
 // for forward compatibility
@@ -142,10 +238,14 @@ type BridgeServiceServer interface {
 	// Getters
 	// / Get api version
 	CheckAPI(context.Context, *CheckAPIRequest) (*CheckAPIResponse, error)
+	// / Get the synchronization status of every network
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
 	// / Get bridges for the destination address both in L1 and L2
 	GetBridges(context.Context, *GetBridgesRequest) (*GetBridgesResponse, error)
 	// / Get the merkle proof for the specific deposit
 	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	// / Get the merkle proofs for several deposits, all of them built against the same GER
+	GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error)
 	// / Get the merkle proof for the specific deposit and GER
 	GetProofByGER(context.Context, *GetProofByGERRequest) (*GetProofResponse, error)
 	// / Get the specific deposit
@@ -154,8 +254,20 @@ type BridgeServiceServer interface {
 	GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error)
 	// / Get token wrapped for the specific smart contract address both in L1 and L2
 	GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error)
+	// / List the wrapped tokens, optionally filtered by the network where they were created and the original network
+	ListTokensWrapped(context.Context, *ListTokensWrappedRequest) (*ListTokensWrappedResponse, error)
+	// / Get the token wrapped for the specific wrapped token address in the network where it was created
+	GetTokenByWrappedAddress(context.Context, *GetTokenByWrappedAddressRequest) (*GetTokenWrappedResponse, error)
 	// / Get pending bridges to claim by the destination address, destination network and leaf type in L1 and L2's
 	GetPendingBridgesToClaim(context.Context, *GetPendingBridgesRequest) (*GetBridgesResponse, error)
+	// / Get the lifecycle status of the specific deposit, from the deposit to the claim in the destination network
+	GetBridgeStatus(context.Context, *GetBridgeStatusRequest) (*GetBridgeStatusResponse, error)
+	// / Get the calldata of the claim tx for the specific deposit, ready to be signed and sent to the destination network
+	GetClaimTxData(context.Context, *GetClaimTxDataRequest) (*GetClaimTxDataResponse, error)
+	// / Verify a claim proof recomputing the local, rollup and global exit roots
+	VerifyClaimProof(context.Context, *VerifyClaimProofRequest) (*VerifyClaimProofResponse, error)
+	// / Get the cost of the claims sent by the claim tx manager per day, token, destination network and signer
+	GetClaimCosts(context.Context, *GetClaimCostsRequest) (*GetClaimCostsResponse, error)
 	mustEmbedUnimplementedBridgeServiceServer()
 }
 
@@ -166,12 +278,18 @@ type UnimplementedBridgeServiceServer struct {
 func (UnimplementedBridgeServiceServer) CheckAPI(context.Context, *CheckAPIRequest) (*CheckAPIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAPI not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetBridges(context.Context, *GetBridgesRequest) (*GetBridgesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBridges not implemented")
 }
 func (UnimplementedBridgeServiceServer) GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofs not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetProofByGER(context.Context, *GetProofByGERRequest) (*GetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProofByGER not implemented")
 }
@@ -184,9 +302,27 @@ func (UnimplementedBridgeServiceServer) GetClaims(context.Context, *GetClaimsReq
 func (UnimplementedBridgeServiceServer) GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenWrapped not implemented")
 }
+func (UnimplementedBridgeServiceServer) ListTokensWrapped(context.Context, *ListTokensWrappedRequest) (*ListTokensWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokensWrapped not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetTokenByWrappedAddress(context.Context, *GetTokenByWrappedAddressRequest) (*GetTokenWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenByWrappedAddress not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetPendingBridgesToClaim(context.Context, *GetPendingBridgesRequest) (*GetBridgesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingBridgesToClaim not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetBridgeStatus(context.Context, *GetBridgeStatusRequest) (*GetBridgeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeStatus not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetClaimTxData(context.Context, *GetClaimTxDataRequest) (*GetClaimTxDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimTxData not implemented")
+}
+func (UnimplementedBridgeServiceServer) VerifyClaimProof(context.Context, *VerifyClaimProofRequest) (*VerifyClaimProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyClaimProof not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetClaimCosts(context.Context, *GetClaimCostsRequest) (*GetClaimCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimCosts not implemented")
+}
 func (UnimplementedBridgeServiceServer) mustEmbedUnimplementedBridgeServiceServer() {}
 
 // UnsafeBridgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +354,24 @@ func _BridgeService_CheckAPI_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBridgesRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +408,24 @@ func _BridgeService_GetProof_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetProofs(ctx, req.(*GetProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetProofByGER_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProofByGERRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +498,42 @@ func _BridgeService_GetTokenWrapped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_ListTokensWrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensWrappedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).ListTokensWrapped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_ListTokensWrapped_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).ListTokensWrapped(ctx, req.(*ListTokensWrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetTokenByWrappedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenByWrappedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetTokenByWrappedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetTokenByWrappedAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetTokenByWrappedAddress(ctx, req.(*GetTokenByWrappedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetPendingBridgesToClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPendingBridgesRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +552,78 @@ func _BridgeService_GetPendingBridgesToClaim_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetBridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetBridgeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetBridgeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetBridgeStatus(ctx, req.(*GetBridgeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetClaimTxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimTxDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetClaimTxData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetClaimTxData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetClaimTxData(ctx, req.(*GetClaimTxDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_VerifyClaimProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyClaimProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).VerifyClaimProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_VerifyClaimProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).VerifyClaimProof(ctx, req.(*VerifyClaimProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetClaimCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetClaimCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetClaimCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetClaimCosts(ctx, req.(*GetClaimCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BridgeService_ServiceDesc is the grpc.ServiceDesc for BridgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,6 +635,10 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAPI",
 			Handler:    _BridgeService_CheckAPI_Handler,
 		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _BridgeService_GetSyncStatus_Handler,
+		},
 		{
 			MethodName: "GetBridges",
 			Handler:    _BridgeService_GetBridges_Handler,
@@ -363,6 +647,10 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProof",
 			Handler:    _BridgeService_GetProof_Handler,
 		},
+		{
+			MethodName: "GetProofs",
+			Handler:    _BridgeService_GetProofs_Handler,
+		},
 		{
 			MethodName: "GetProofByGER",
 			Handler:    _BridgeService_GetProofByGER_Handler,
@@ -379,10 +667,34 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTokenWrapped",
 			Handler:    _BridgeService_GetTokenWrapped_Handler,
 		},
+		{
+			MethodName: "ListTokensWrapped",
+			Handler:    _BridgeService_ListTokensWrapped_Handler,
+		},
+		{
+			MethodName: "GetTokenByWrappedAddress",
+			Handler:    _BridgeService_GetTokenByWrappedAddress_Handler,
+		},
 		{
 			MethodName: "GetPendingBridgesToClaim",
 			Handler:    _BridgeService_GetPendingBridgesToClaim_Handler,
 		},
+		{
+			MethodName: "GetBridgeStatus",
+			Handler:    _BridgeService_GetBridgeStatus_Handler,
+		},
+		{
+			MethodName: "GetClaimTxData",
+			Handler:    _BridgeService_GetClaimTxData_Handler,
+		},
+		{
+			MethodName: "VerifyClaimProof",
+			Handler:    _BridgeService_VerifyClaimProof_Handler,
+		},
+		{
+			MethodName: "GetClaimCosts",
+			Handler:    _BridgeService_GetClaimCosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query.proto",
@@ -0,0 +1,73 @@
+package claimtxman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+)
+
+// BridgeClaimedCaller is the destination bridge contract binding used to check the claimed deposits.
+type BridgeClaimedCaller interface {
+	IsClaimed(opts *bind.CallOpts, leafIndex uint32, sourceBridgeNetwork uint32) (bool, error)
+}
+
+// ClaimedChecker checks in the destination bridge contract whether a deposit is already claimed. Anyone
+// can claim a deposit and the synchronized claims can be behind the network, so the on-chain state is
+// checked right before sending a claim to not waste gas in a reverted tx.
+type ClaimedChecker struct {
+	bridge   BridgeClaimedCaller
+	composer *ComposeCompressClaim
+}
+
+// NewClaimedChecker creates a new claimed checker.
+func NewClaimedChecker(bridge BridgeClaimedCaller) (*ClaimedChecker, error) {
+	composer, err := NewComposeCompressClaim()
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimedChecker{
+		bridge:   bridge,
+		composer: composer,
+	}, nil
+}
+
+// IsDepositClaimed returns whether the deposit with the deposit count of the origin network is claimed.
+func (c *ClaimedChecker) IsDepositClaimed(ctx context.Context, depositCount, networkID uint32) (bool, error) {
+	claimed, err := c.bridge.IsClaimed(&bind.CallOpts{Context: ctx}, depositCount, networkID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if deposit count %d of network %d is claimed: %w", depositCount, networkID, err)
+	}
+	return claimed, nil
+}
+
+// IsClaimTxClaimed returns whether the deposit claimed by the claimAsset or claimMessage calldata is
+// already claimed.
+func (c *ClaimedChecker) IsClaimTxClaimed(ctx context.Context, data []byte) (bool, error) {
+	depositCount, networkID, err := c.claimedDeposit(data)
+	if err != nil {
+		return false, err
+	}
+	return c.IsDepositClaimed(ctx, depositCount, networkID)
+}
+
+// claimedDeposit returns the deposit count and the origin network of the deposit claimed by the calldata,
+// decoded from its global index. The rollup index of the global index is the network id minus 1.
+func (c *ClaimedChecker) claimedDeposit(data []byte) (uint32, uint32, error) {
+	if len(data) < 4 {
+		return 0, 0, fmt.Errorf("invalid claim calldata of %d bytes", len(data))
+	}
+	params, err := c.composer.extractParams(data)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to decode the claim calldata: %w", err)
+	}
+	mainnetFlag, rollupIndex, depositCount, err := etherman.DecodeGlobalIndex(params.globalIndex)
+	if err != nil {
+		return 0, 0, err
+	}
+	if mainnetFlag {
+		return depositCount, 0, nil
+	}
+	return depositCount, rollupIndex + 1, nil
+}
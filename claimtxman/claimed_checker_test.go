@@ -0,0 +1,73 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/common"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/test/mocksmartcontracts/polygonzkevmbridge"
+	"github.com/fiwallets/zkevm-bridge-service/utils"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func claimAssetData(t *testing.T, globalIndex *big.Int) []byte {
+	smcAbi, err := polygonzkevmbridge.PolygonzkevmbridgeMetaData.GetAbi()
+	require.NoError(t, err)
+	data, err := smcAbi.Pack("claimAsset", [32][32]byte{}, [32][32]byte{}, globalIndex, [32]byte{}, [32]byte{},
+		uint32(0), common.Address{}, uint32(1), common.HexToAddress("0x1"), big.NewInt(1), []byte{})
+	require.NoError(t, err)
+	return data
+}
+
+func TestClaimedCheckerIsClaimTxClaimed(t *testing.T) {
+	ctx := context.Background()
+	bridge := mock_txcompressor.NewBridgeClaimedCaller(t)
+	c, err := NewClaimedChecker(bridge)
+	require.NoError(t, err)
+
+	// The deposits of mainnet are checked with the network 0
+	bridge.EXPECT().IsClaimed(mock.Anything, uint32(5), uint32(0)).Return(true, nil).Once()
+	claimed, err := c.IsClaimTxClaimed(ctx, claimAssetData(t, etherman.GenerateGlobalIndex(true, 0, 5)))
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// The deposits of a rollup are checked with its network id, the rollup index plus 1
+	bridge.EXPECT().IsClaimed(mock.Anything, uint32(7), uint32(3)).Return(false, nil).Once()
+	claimed, err = c.IsClaimTxClaimed(ctx, claimAssetData(t, etherman.GenerateGlobalIndex(false, 2, 7)))
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	bridge.EXPECT().IsClaimed(mock.Anything, uint32(7), uint32(3)).Return(false, errors.New("failure")).Once()
+	_, err = c.IsClaimTxClaimed(ctx, claimAssetData(t, etherman.GenerateGlobalIndex(false, 2, 7)))
+	require.Error(t, err)
+
+	// The calldata is not a claim
+	_, err = c.IsClaimTxClaimed(ctx, []byte{0x01})
+	require.Error(t, err)
+	_, err = c.IsClaimTxClaimed(ctx, []byte{0x01, 0x02, 0x03, 0x04})
+	require.ErrorIs(t, err, ErrMethodUnknown)
+}
+
+func TestPendingTxsSetClaimedExternally(t *testing.T) {
+	pendingTxs, err := NewPendingTxs(nil, nil, 0)
+	require.NoError(t, err)
+	pendingTxs.TxCandidatesForGroup = append(pendingTxs.TxCandidatesForGroup,
+		ctmtypes.MonitoredTx{DepositID: 1, Status: ctmtypes.MonitoredTxStatusCreated},
+		ctmtypes.MonitoredTx{DepositID: 2, Status: ctmtypes.MonitoredTxStatusCreated})
+	oldState := pendingTxs
+
+	pendingTxs.setClaimedExternally(pendingTxs.TxCandidatesForGroup[0])
+	require.Len(t, pendingTxs.TxCandidatesForGroup, 1)
+	require.Equal(t, uint64(2), pendingTxs.TxCandidatesForGroup[0].DepositID)
+
+	// The claimed externally txs are stored
+	changes, err := GenerateStoreUpdate(oldState, pendingTxs, utils.NewTimeProviderSystemLocalTime())
+	require.NoError(t, err)
+	require.Equal(t, []ctmtypes.MonitoredTx{{DepositID: 1, Status: ctmtypes.MonitoredTxStatusClaimedExternally}}, changes.UpdateTxs)
+}
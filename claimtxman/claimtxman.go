@@ -5,12 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
@@ -25,12 +29,28 @@ const (
 	keyLen          = 32
 	mtHeight        = 32
 	LeafTypeMessage = uint8(1)
+
+	queueMetricsInterval = 30 * time.Second
 )
 
+// monitoredTxStatuses are the statuses reported in the queue size metrics
+var monitoredTxStatuses = []ctmtypes.MonitoredTxStatus{
+	ctmtypes.MonitoredTxStatusCreated,
+	ctmtypes.MonitoredTxStatusFailed,
+	ctmtypes.MonitoredTxStatusConfirmed,
+	ctmtypes.MonitoredTxStatusCompressing,
+	ctmtypes.MonitoredTxStatusClaiming,
+	ctmtypes.MonitoredTxStatusClaimedExternally,
+}
+
 // ClaimTxManager is the claim transaction manager for L2.
 type ClaimTxManager struct {
 	ctx    context.Context
 	cancel context.CancelFunc
+	// stopCh is closed to stop the monitor loop, wg tracks the deposits being processed
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
 
 	// client is the ethereum client
 	l2Node          *utils.Client
@@ -40,11 +60,15 @@ type ClaimTxManager struct {
 	chExitRootEvent chan *etherman.GlobalExitRoot
 	chSynced        chan uint32
 	storage         StorageInterface
-	auth            *bind.TransactOpts
+	signers         *SignerPool
 	rollupID        uint32
 	l2Synced        bool
-	nonceCache      *NonceCache
+	nonces          *NonceAllocator
+	claimed         *ClaimedChecker
+	policy          *ClaimPolicy
 	monitorTxs      types.TxMonitorer
+	// lastActivity is the unix nano time of the latest iteration of the monitor loop
+	lastActivity atomic.Int64
 }
 
 // NewClaimTxManager creates a new claim transaction manager.
@@ -57,25 +81,57 @@ func NewClaimTxManager(ctx context.Context, cfg Config, chExitRootEvent chan *et
 	storage interface{},
 	rollupID uint32,
 	etherMan EthermanI,
-	nonceCache *NonceCache,
-	auth *bind.TransactOpts) (*ClaimTxManager, error) {
+	signers []*bind.TransactOpts) (*ClaimTxManager, error) {
+	if cfg.GroupingClaims.Enabled && len(signers) > 1 {
+		return nil, fmt.Errorf("%d claim signers configured, the compressed claims are sent by a single signer", len(signers))
+	}
 	client, err := utils.NewClient(ctx, l2NodeURL, l2BridgeAddr)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.TxReplacement.Enabled && cfg.TxReplacement.FeeBumpPercentage < minFeeBumpPercentage {
+		return nil, fmt.Errorf("invalid TxReplacement.FeeBumpPercentage %d, the nodes reject the replacements that bump the fees less than %d%%",
+			cfg.TxReplacement.FeeBumpPercentage, minFeeBumpPercentage)
+	}
+	gasPricer, err := NewGasPricer(cfg.TxFees.GasPricer, client)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := newTxFees(cfg.TxFees, client, gasPricer, l2NetworkID)
+	if err != nil {
+		return nil, err
+	}
+	nonces := NewNonceAllocator(storage, client, l2NetworkID)
+	signerPool, err := NewSignerPool(signers, storage, client, nonces, l2NetworkID, new(big.Int).SetUint64(cfg.MinSignerBalance))
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := NewClaimedChecker(client.Bridge)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := NewClaimPrioritizer(cfg.Priority)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := NewClaimPolicy(cfg.Policies, cfg.AuthorizedClaimMessageAddresses, storage, utils.NewTimeProviderSystemLocalTime(), l2NetworkID)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithCancel(ctx)
 
 	var monitorTx ctmtypes.TxMonitorer
 	if cfg.GroupingClaims.Enabled {
 		log.Info("ClaimTxManager working in compressor mode to group claim txs")
-		monitorTx = NewMonitorCompressedTxs(ctx, storage.(StorageCompressedInterface), client, cfg, nonceCache, auth, etherMan, utils.NewTimeProviderSystemLocalTime(), cfg.GroupingClaims.GasOffset, rollupID)
+		monitorTx = NewMonitorCompressedTxs(ctx, storage.(StorageCompressedInterface), client, cfg, signerPool.Default(), etherMan, utils.NewTimeProviderSystemLocalTime(), cfg.GroupingClaims.GasOffset, rollupID, fees, claimed, priority)
 	} else {
 		log.Info("ClaimTxManager working in regular mode to send claim txs individually")
-		monitorTx = NewMonitorTxs(ctx, storage.(StorageInterface), client, cfg, nonceCache, rollupID, auth)
+		monitorTx = NewMonitorTxs(ctx, storage.(StorageInterface), client, cfg, nonces, rollupID, signerPool, fees, claimed, priority)
 	}
-	return &ClaimTxManager{
+	tm := &ClaimTxManager{
 		ctx:             ctx,
 		cancel:          cancel,
+		stopCh:          make(chan struct{}),
 		l2Node:          client,
 		l2NetworkID:     l2NetworkID,
 		bridgeService:   bridgeService,
@@ -83,25 +139,37 @@ func NewClaimTxManager(ctx context.Context, cfg Config, chExitRootEvent chan *et
 		chExitRootEvent: chExitRootEvent,
 		chSynced:        chSynced,
 		storage:         storage.(StorageInterface),
-		auth:            auth,
+		signers:         signerPool,
 		rollupID:        rollupID,
-		nonceCache:      nonceCache,
+		nonces:          nonces,
+		claimed:         claimed,
+		policy:          policy,
 		monitorTxs:      monitorTx,
-	}, err
+	}
+	tm.lastActivity.Store(time.Now().UnixNano())
+	return tm, err
 }
 
 // Start will start the tx management, reading txs from storage,
 // send then to the blockchain and keep monitoring them until they
-// get mined
+// get mined. It returns when the manager is stopped, once the deposits
+// being processed are stored.
 func (tm *ClaimTxManager) Start() {
 	ticker := time.NewTicker(tm.cfg.FrequencyToMonitorTxs.Duration)
 	compressorTicker := time.NewTicker(tm.cfg.GroupingClaims.FrequencyToProcessCompressedClaims.Duration)
+	queueMetricsTicker := time.NewTicker(queueMetricsInterval)
+	defer queueMetricsTicker.Stop()
+	defer compressorTicker.Stop()
+	defer ticker.Stop()
+	defer tm.wg.Wait()
 	var ger = &etherman.GlobalExitRoot{}
 	var latestProcessedGer common.Hash
 	for {
 		select {
 		case <-tm.ctx.Done():
-			ticker.Stop()
+			return
+		case <-tm.stopCh:
+			log.Infof("rollupID: %d, claim tx manager stopped", tm.rollupID)
 			return
 		case netID := <-tm.chSynced:
 			if netID == tm.l2NetworkID && !tm.l2Synced {
@@ -115,7 +183,9 @@ func (tm *ClaimTxManager) Start() {
 					log.Debugf("rollupID: %d, Ger value updated and ready to be processed...", tm.rollupID)
 					continue
 				}
+				tm.wg.Add(1)
 				go func() {
+					defer tm.wg.Done()
 					err := tm.updateDepositsStatus(ger)
 					if err != nil {
 						log.Errorf("rollupID: %d, failed to update deposits status: %v", tm.rollupID, err)
@@ -127,7 +197,9 @@ func (tm *ClaimTxManager) Start() {
 		case <-compressorTicker.C:
 			if tm.l2Synced && tm.cfg.GroupingClaims.Enabled && ger.GlobalExitRoot != latestProcessedGer {
 				log.Infof("RollupID: %d,Processing deposits for ger: %s", tm.rollupID, ger.GlobalExitRoot.String())
+				tm.wg.Add(1)
 				go func() {
+					defer tm.wg.Done()
 					err := tm.updateDepositsStatus(ger)
 					if err != nil {
 						log.Errorf("rollupID: %d, failed to update deposits status: %v", tm.rollupID, err)
@@ -140,10 +212,37 @@ func (tm *ClaimTxManager) Start() {
 			if err != nil {
 				log.Errorf("rollupID: %d, failed to monitor txs: %v", tm.rollupID, err)
 			}
+			tm.lastActivity.Store(time.Now().UnixNano())
+		case <-queueMetricsTicker.C:
+			tm.updateQueueMetrics()
 		}
 	}
 }
 
+// Stop stops the monitor loop after the current iteration. Start returns once the deposits being
+// processed are stored. Cancel the parent context to abort the claim tx manager instead.
+func (tm *ClaimTxManager) Stop() {
+	tm.stopOnce.Do(func() { close(tm.stopCh) })
+}
+
+// updateQueueMetrics reports the number of monitored txs in each status.
+func (tm *ClaimTxManager) updateQueueMetrics() {
+	counts, err := tm.storage.GetClaimTxsCountByStatus(tm.ctx, tm.rollupID, nil)
+	if err != nil {
+		log.Errorf("rollupID: %d, failed to count the monitored txs: %v", tm.rollupID, err)
+		return
+	}
+	for _, status := range monitoredTxStatuses {
+		metrics.MonitoredTxs(tm.rollupID, string(status), counts[status])
+	}
+}
+
+// LastActivity returns the time of the latest iteration of the monitor loop, or the creation time
+// if the loop hasn't run yet.
+func (tm *ClaimTxManager) LastActivity() time.Time {
+	return time.Unix(0, tm.lastActivity.Load())
+}
+
 func (tm *ClaimTxManager) updateDepositsStatus(ger *etherman.GlobalExitRoot) error {
 	dbTx, err := tm.storage.BeginDBTransaction(tm.ctx)
 	if err != nil {
@@ -172,11 +271,14 @@ func (tm *ClaimTxManager) updateDepositsStatus(ger *etherman.GlobalExitRoot) err
 	return nil
 }
 
-func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
+func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbTx pgx.Tx) (err error) {
+	ctx, span := tracing.StartSpan(tm.ctx, "claimtxman.processDepositStatus", tracing.RollupID(tm.rollupID),
+		tracing.NetworkID(ger.NetworkID), tracing.GlobalExitRoot(ger.GlobalExitRoot))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var (
 		deposits       []*etherman.Deposit
 		globalExitRoot = ger.GlobalExitRoot
-		err            error
 	)
 	if ger.BlockID != 0 && ger.NetworkID == 0 { // L2 exit root is updated
 		log.Infof("RollupID: %d, Rollup exitroot %v is updated", tm.rollupID, ger.ExitRoots[1])
@@ -218,65 +320,126 @@ func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbT
 			log.Errorf("rollupID: %d, error getting and updating L1DepositsStatus. Error: %v", tm.rollupID, err)
 			return err
 		}
+		// The deposits deferred by the daily budgets are checked again the next day
+		var deferred []*etherman.Deposit
+		deferred, err = tm.policy.Deferred(ctx, dbTx)
+		if err != nil {
+			log.Errorf("rollupID: %d, error getting the deposits deferred by the claim policies. Error: %v", tm.rollupID, err)
+			return err
+		}
+		deposits = append(deposits, deferred...)
 	}
 	for _, deposit := range deposits {
-		if tm.l2NetworkID != deposit.DestinationNetwork {
-			log.Infof("Ignoring deposit id: %d deposit count:%d dest_net: %d, we are:%d", deposit.Id, deposit.DepositCount, deposit.DestinationNetwork, tm.l2NetworkID)
-			continue
+		if err = tm.buildClaimTx(ctx, deposit, globalExitRoot, dbTx); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// buildClaimTx creates the monitored claim tx of a deposit ready to be claimed, unless it is
+// already claimed or it is rejected by the claim policies. The previous rejection of the deposit is
+// removed once the policies accept it. The deposits found claimed on-chain
+// but not synchronized yet are stored as claimed externally.
+func (tm *ClaimTxManager) buildClaimTx(ctx context.Context, deposit *etherman.Deposit, globalExitRoot common.Hash, dbTx pgx.Tx) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "claimtxman.buildClaimTx", append(tracing.Deposit(deposit.Id, deposit.DepositCount,
+		deposit.NetworkID, deposit.DestinationNetwork), tracing.RollupID(tm.rollupID))...)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if tm.l2NetworkID != deposit.DestinationNetwork {
+		log.Infof("Ignoring deposit id: %d deposit count:%d dest_net: %d, we are:%d", deposit.Id, deposit.DepositCount, deposit.DestinationNetwork, tm.l2NetworkID)
+		return nil
+	}
 
-		claimHash, err := tm.bridgeService.GetDepositStatus(tm.ctx, deposit.DepositCount, deposit.NetworkID, deposit.DestinationNetwork)
+	claimHash, err := tm.bridgeService.GetDepositStatus(ctx, deposit.DepositCount, deposit.NetworkID, deposit.DestinationNetwork)
+	if err != nil {
+		log.Errorf("rollupID: %d, error getting deposit status for deposit id %d. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
+	if len(claimHash) > 0 {
+		log.Infof("RollupID: %d, Ignoring deposit Id: %d, leafType: %d, claimHash: %s, deposit.OriginalAddress: %s", tm.rollupID, deposit.Id, deposit.LeafType, claimHash, deposit.OriginalAddress.String())
+		return nil
+	}
+	rejection, err := tm.policy.Check(ctx, deposit, dbTx)
+	if err != nil {
+		log.Errorf("rollupID: %d, error checking the claim policies of deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
+	if rejection != nil {
+		rejected, err := tm.policy.Reject(ctx, deposit, rejection, dbTx)
 		if err != nil {
-			log.Errorf("rollupID: %d, error getting deposit status for deposit id %d. Error: %v", tm.rollupID, deposit.Id, err)
+			log.Errorf("rollupID: %d, error storing the rejection of deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
 			return err
 		}
-		if len(claimHash) > 0 || deposit.LeafType == LeafTypeMessage && !tm.isDepositMessageAllowed(deposit) {
-			log.Infof("RollupID: %d, Ignoring deposit Id: %d, leafType: %d, claimHash: %s, deposit.OriginalAddress: %s", tm.rollupID, deposit.Id, deposit.LeafType, claimHash, deposit.OriginalAddress.String())
-			continue
+		if rejected {
+			log.Infof("RollupID: %d, deposit Id: %d rejected by the %s claim policy: %s", tm.rollupID, deposit.Id, rejection.Rule, rejection.Reason)
+			metrics.ClaimRejected(tm.rollupID, rejection.Rule)
 		}
+		return nil
+	}
+	if err = tm.policy.Accept(ctx, deposit, dbTx); err != nil {
+		log.Errorf("rollupID: %d, error removing the rejection of deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
 
-		log.Infof("RollupID: %d, create the claim tx for the deposit count %d. Deposit Id: %d", tm.rollupID, deposit.DepositCount, deposit.Id)
-		ger, proof, rollupProof, err := tm.bridgeService.GetClaimProofForCompressed(globalExitRoot, deposit.DepositCount, deposit.NetworkID, dbTx)
+	log.Infof("RollupID: %d, create the claim tx for the deposit count %d. Deposit Id: %d", tm.rollupID, deposit.DepositCount, deposit.Id)
+	ger, proof, rollupProof, err := tm.bridgeService.GetClaimProofForCompressed(globalExitRoot, deposit.DepositCount, deposit.NetworkID, dbTx)
+	if err != nil {
+		log.Errorf("rollupID: %d, error getting Claim Proof for deposit Id %d. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
+	var (
+		mtProof       [mtHeight][keyLen]byte
+		mtRollupProof [mtHeight][keyLen]byte
+	)
+	for i := 0; i < mtHeight; i++ {
+		mtProof[i] = proof[i]
+		mtRollupProof[i] = rollupProof[i]
+	}
+	// the compressed claims are sent by the default signer
+	auth := tm.signers.Default()
+	if !tm.cfg.GroupingClaims.Enabled {
+		auth, err = tm.signers.SelectSigner(ctx, dbTx)
 		if err != nil {
-			log.Errorf("rollupID: %d, error getting Claim Proof for deposit Id %d. Error: %v", tm.rollupID, deposit.Id, err)
+			log.Errorf("rollupID: %d, error selecting the signer for deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
 			return err
 		}
-		var (
-			mtProof       [mtHeight][keyLen]byte
-			mtRollupProof [mtHeight][keyLen]byte
-		)
-		for i := 0; i < mtHeight; i++ {
-			mtProof[i] = proof[i]
-			mtRollupProof[i] = rollupProof[i]
-		}
-		tx, err := tm.l2Node.BuildSendClaim(tm.ctx, deposit, mtProof, mtRollupProof,
-			&etherman.GlobalExitRoot{
-				ExitRoots: []common.Hash{
-					ger.ExitRoots[0],
-					ger.ExitRoots[1],
-				}}, 1, 1, 1,
-			tm.auth)
-		if err != nil {
-			log.Errorf("rollupID: %d, error BuildSendClaim tx for deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
-			return err
+	}
+	tx, err := tm.l2Node.BuildSendClaim(ctx, deposit, mtProof, mtRollupProof,
+		&etherman.GlobalExitRoot{
+			ExitRoots: []common.Hash{
+				ger.ExitRoots[0],
+				ger.ExitRoots[1],
+			}}, 1, 1, 1,
+		auth)
+	if err != nil {
+		log.Errorf("rollupID: %d, error BuildSendClaim tx for deposit Id: %d. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
+	claimed, err := tm.claimed.IsDepositClaimed(ctx, deposit.DepositCount, deposit.NetworkID)
+	if err != nil {
+		log.Errorf("rollupID: %d, error checking if deposit Id: %d is claimed. Error: %v", tm.rollupID, deposit.Id, err)
+		return err
+	}
+	if claimed {
+		log.Infof("RollupID: %d, deposit Id: %d already claimed on-chain by another tx", tm.rollupID, deposit.Id)
+		metrics.ClaimedExternally(tm.rollupID)
+		mTx := ctmtypes.MonitoredTx{
+			DepositID: deposit.Id, From: auth.From, To: tx.To(),
+			Data: tx.Data(), Status: ctmtypes.MonitoredTxStatusClaimedExternally,
+			GlobalExitRoot: ger.GlobalExitRoot,
 		}
-		if err = tm.addClaimTx(deposit.Id, tm.auth.From, tx.To(), nil, tx.Data(), ger.GlobalExitRoot, dbTx); err != nil {
-			log.Errorf("rollupID: %d, error adding claim tx for deposit Id: %d Error: %v", tm.rollupID, deposit.Id, err)
+		if err = tm.storage.AddClaimTx(ctx, mTx, dbTx); err != nil {
+			log.Errorf("rollupID: %d, error adding claimed externally tx for deposit Id: %d Error: %v", tm.rollupID, deposit.Id, err)
 			return err
 		}
+		return nil
 	}
-	return nil
-}
-
-func (tm *ClaimTxManager) isDepositMessageAllowed(deposit *etherman.Deposit) bool {
-	for _, addr := range tm.cfg.AuthorizedClaimMessageAddresses {
-		if deposit.OriginalAddress == addr {
-			log.Infof("RollupID: %d, MessageBridge from authorized account detected: %+v, account: %s", tm.rollupID, deposit, addr.String())
-			return true
-		}
+	if err = tm.addClaimTx(deposit.Id, auth.From, tx.To(), nil, tx.Data(), ger.GlobalExitRoot, dbTx); err != nil {
+		log.Errorf("rollupID: %d, error adding claim tx for deposit Id: %d Error: %v", tm.rollupID, deposit.Id, err)
+		return err
 	}
-	log.Infof("RollupID: %d, MessageBridge Not authorized. DepositCount: %d. DepositID: %d", tm.rollupID, deposit.DepositCount, deposit.Id)
-	return false
+	return nil
 }
 
 func (tm *ClaimTxManager) addClaimTx(depositID uint64, from common.Address, to *common.Address, value *big.Int, data []byte, ger common.Hash, dbTx pgx.Tx) error {
@@ -314,18 +477,11 @@ func (tm *ClaimTxManager) addClaimTx(depositID uint64, from common.Address, to *
 		log.Errorf("rollupID: %d, failed to estimate gas. Ignoring tx... Error: %v, data: %s, GER: %s", tm.rollupID, err, common.Bytes2Hex(data), ger.String())
 		return nil
 	}
-	// get next nonce
-	nonce, err := tm.nonceCache.GetNextNonce(from)
-	if err != nil {
-		err := fmt.Errorf("rollupID: %d, failed to get current nonce: %v", tm.rollupID, err)
-		log.Errorf("error getting next nonce. Error: %s", err.Error())
-		return err
-	}
-
-	// create monitored tx
+	// create monitored tx. The nonce is reserved when the tx is sent for the first time, so the
+	// claims get their nonces in priority order
 	mTx := ctmtypes.MonitoredTx{
 		DepositID: depositID, From: from, To: to,
-		Nonce: nonce, Value: value, Data: data,
+		Value: value, Data: data,
 		Gas: gas, Status: ctmtypes.MonitoredTxStatusCreated,
 		GlobalExitRoot: ger,
 	}
@@ -344,7 +500,7 @@ func (tm *ClaimTxManager) addClaimTx(depositID uint64, from common.Address, to *
 // ReviewMonitoredTx checks if tx needs to be updated
 // accordingly to the current information stored and the current
 // state of the blockchain
-func (tm *ClaimTxManager) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, reviewNonce bool) error {
+func (tm *ClaimTxManager) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, reviewNonce bool, dbTx pgx.Tx) error {
 	mTxLog := log.WithFields("monitoredTx", mTx.DepositID, "rollupID", tm.rollupID)
 	mTxLog.Debug("reviewing")
 	// get gas
@@ -373,14 +529,20 @@ func (tm *ClaimTxManager) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.M
 	}
 
 	if reviewNonce {
-		// check nonce
-		nonce, err := tm.nonceCache.GetNextNonce(mTx.From)
+		// check nonce. A new nonce is reserved only when the current one was consumed by another
+		// tx, otherwise it is kept to not leave a gap
+		consumed, err := tm.nonces.IsNonceConsumed(ctx, mTx.From, mTx.Nonce)
 		if err != nil {
-			err := fmt.Errorf("failed to get nonce: %v", err)
 			mTxLog.Errorf(err.Error())
 			return err
 		}
-		if nonce > mTx.Nonce {
+		if consumed {
+			nonce, err := tm.nonces.ReserveNonce(ctx, mTx.From, dbTx)
+			if err != nil {
+				err := fmt.Errorf("failed to reserve nonce: %v", err)
+				mTxLog.Errorf(err.Error())
+				return err
+			}
 			mTxLog.Infof("monitored tx nonce updated from %v to %v", mTx.Nonce, nonce)
 			mTx.Nonce = nonce
 		}
@@ -77,6 +77,9 @@ func TestMonitoredTxStorage(t *testing.T) {
 		Data:  mTx.Data,
 		Gas:   mTx.Gas,
 	})))
+	sentAt := time.Date(2023, 10, 3, 10, 29, 8, 0, time.UTC)
+	mTx.SentAt = &sentAt
+	mTx.Bumps = 2
 	err = pg.UpdateClaimTx(ctx, mTx, tx)
 	require.NoError(t, err)
 
@@ -88,6 +91,8 @@ func TestMonitoredTxStorage(t *testing.T) {
 		Value:     big.NewInt(1000000),
 		Data:      common.FromHex("0x0"),
 		Gas:       1000000,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(30000000000),
 		Status:    ctmtypes.MonitoredTxStatusConfirmed,
 		History:   make(map[common.Hash]bool),
 	}
@@ -97,6 +102,21 @@ func TestMonitoredTxStorage(t *testing.T) {
 	mTxs, err := pg.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}, 1, tx)
 	require.NoError(t, err)
 	require.Len(t, mTxs, 1)
+	require.Nil(t, mTxs[0].GasFeeCap)
+	// The replacement info is persisted
+	require.True(t, sentAt.Equal(*mTxs[0].SentAt))
+	require.Equal(t, uint64(2), mTxs[0].Bumps)
+
+	// The fees are persisted
+	mTxs, err = pg.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusConfirmed}, 1, tx)
+	require.NoError(t, err)
+	require.Len(t, mTxs, 1)
+	require.Nil(t, mTxs[0].GasPrice)
+	require.Equal(t, big.NewInt(1000000000), mTxs[0].GasTipCap)
+	require.Equal(t, big.NewInt(30000000000), mTxs[0].GasFeeCap)
+	require.Equal(t, types.DynamicFeeTxType, int(mTxs[0].Tx().Type()))
+	require.Nil(t, mTxs[0].SentAt)
+	require.Equal(t, uint64(0), mTxs[0].Bumps)
 
 	mTxs, err = pg.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated, ctmtypes.MonitoredTxStatusConfirmed}, 1, tx)
 	require.NoError(t, err)
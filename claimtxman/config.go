@@ -3,6 +3,7 @@ package claimtxman
 import (
 	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/zkevm-bridge-service/remotesigner"
 )
 
 // Config is configuration for L2 claim transaction manager
@@ -11,9 +12,17 @@ type Config struct {
 	Enabled bool `mapstructure:"Enabled"`
 	// FrequencyToMonitorTxs frequency of the resending failed txs
 	FrequencyToMonitorTxs types.Duration `mapstructure:"FrequencyToMonitorTxs"`
-	// PrivateKey defines the key store file that is going
-	// to be read in order to provide the private key to sign the claim txs
-	PrivateKey types.KeystoreFileConfig `mapstructure:"PrivateKey"`
+	// PrivateKey defines the key store files that are going
+	// to be read in order to provide the private keys to sign the claim txs.
+	// It accepts a single key store or a list of them, the claims are distributed among them.
+	// With GroupingClaims a single key store or remote signer is allowed, as the compressed claims are
+	// sent by one signer. At least one key store or remote signer is required
+	PrivateKey []types.KeystoreFileConfig `mapstructure:"PrivateKey"`
+	// RemoteSigners are the remote signers that sign the claim txs, as an alternative or in
+	// addition to the key stores
+	RemoteSigners []remotesigner.Config `mapstructure:"RemoteSigners"`
+	// MinSignerBalance is the minimum balance in wei of a signer to be assigned new claims
+	MinSignerBalance uint64 `mapstructure:"MinSignerBalance"`
 	// RetryInterval is time between each retry
 	RetryInterval types.Duration `mapstructure:"RetryInterval"`
 	// RetryNumber is the number of retries before giving up
@@ -22,13 +31,181 @@ type Config struct {
 	AuthorizedClaimMessageAddresses []common.Address `mapstructure:"AuthorizedClaimMessageAddresses"`
 	// Enables the ability to Claim bridges between L2s automatically
 	AreClaimsBetweenL2sEnabled bool `mapstructure:"AreClaimsBetweenL2sEnabled"`
+	// FrequencyToPollSyncEvents is the frequency to read the synced state from the database
+	// when the synchronizers run in another process
+	FrequencyToPollSyncEvents types.Duration `mapstructure:"FrequencyToPollSyncEvents"`
+	// SyncedBlocksLag is the number of blocks the synced L2 state can be behind the L2 head to be
+	// considered synced when the synchronizers run in another process
+	SyncedBlocksLag uint64 `mapstructure:"SyncedBlocksLag"`
 
 	// GroupingClaims is the configuration for grouping claims
 	GroupingClaims ConfigGroupingClaims `mapstructure:"GroupingClaims"`
+
+	// TxFees is the configuration of the fees paid by the claim txs
+	TxFees ConfigTxFees `mapstructure:"TxFees"`
+
+	// TxReplacement is the configuration of the replacement of the claim txs stuck in the pool
+	TxReplacement ConfigTxReplacement `mapstructure:"TxReplacement"`
+
+	// Policies is the configuration of the policies that decide which deposits are claimed
+	Policies ConfigPolicies `mapstructure:"Policies"`
+
+	// Priority is the configuration of the order the pending claims are sent and grouped
+	Priority ConfigPriority `mapstructure:"Priority"`
+}
+
+const (
+	// TipCapStrategySuggested uses the tip suggested by the node multiplied by the TipCapMultiplier
+	TipCapStrategySuggested = "suggested"
+	// TipCapStrategyFixed uses the FixedTipCap
+	TipCapStrategyFixed = "fixed"
+
+	// FeeCapStrategyBaseFee uses the base fee of the latest block multiplied by the
+	// BaseFeeMultiplier plus the tip
+	FeeCapStrategyBaseFee = "basefee"
+	// FeeCapStrategyFixed uses the FixedFeeCap
+	FeeCapStrategyFixed = "fixed"
+	// FeeCapStrategyGasPricer uses the gas price of the GasPricer
+	FeeCapStrategyGasPricer = "gaspricer"
+)
+
+// ConfigTxFees is the configuration of the fees of the claim txs, sent individually or compressed.
+// EIP-1559 txs are sent unless the network is configured to use legacy txs or it doesn't have a base fee.
+type ConfigTxFees struct {
+	// LegacyNetworks are the L2 networks where legacy txs are sent
+	LegacyNetworks []uint32 `mapstructure:"LegacyNetworks"`
+	// MaxGasPrice is the maximum gas price in wei a claim tx can pay. Claims that need a higher
+	// gas price are deferred. 0 means no limit
+	MaxGasPrice uint64 `mapstructure:"MaxGasPrice"`
+	// MaxClaimCost is the maximum cost in wei of a claim tx, its gas times its gas price. Claims
+	// that cost more are deferred. 0 means no limit
+	MaxClaimCost uint64 `mapstructure:"MaxClaimCost"`
+	// GasPricer is the configuration of the gas price of the legacy txs, and of the fee cap of the
+	// EIP-1559 txs with the gaspricer strategy
+	GasPricer ConfigGasPricer `mapstructure:"GasPricer"`
+	// TipCapStrategy is how the tip of the EIP-1559 txs is chosen: suggested or fixed
+	TipCapStrategy string `mapstructure:"TipCapStrategy"`
+	// TipCapMultiplier multiplies the tip suggested by the node with the suggested strategy
+	TipCapMultiplier float64 `mapstructure:"TipCapMultiplier"`
+	// FixedTipCap is the tip in wei with the fixed strategy. With the suggested strategy it is the
+	// minimum tip
+	FixedTipCap uint64 `mapstructure:"FixedTipCap"`
+	// FeeCapStrategy is how the fee cap of the EIP-1559 txs is chosen: basefee, fixed or gaspricer
+	FeeCapStrategy string `mapstructure:"FeeCapStrategy"`
+	// BaseFeeMultiplier multiplies the base fee of the latest block with the basefee strategy. It
+	// is the number of full blocks the tx can wait without being underpriced
+	BaseFeeMultiplier float64 `mapstructure:"BaseFeeMultiplier"`
+	// FixedFeeCap is the fee cap in wei with the fixed strategy
+	FixedFeeCap uint64 `mapstructure:"FixedFeeCap"`
+}
+
+// ConfigGasPricer is the configuration of the gas pricer of the legacy claim txs, also used as the
+// fee cap of the EIP-1559 claim txs with the gaspricer strategy
+type ConfigGasPricer struct {
+	// Type is the gas pricer: fixed, multiplier, percentile or oracle
+	Type string `mapstructure:"Type"`
+	// FixedGasPrice is the gas price in wei with the fixed gas pricer
+	FixedGasPrice uint64 `mapstructure:"FixedGasPrice"`
+	// Multiplier multiplies the gas price suggested by the node with the multiplier gas pricer
+	Multiplier float64 `mapstructure:"Multiplier"`
+	// Blocks is the number of recent blocks read by the percentile gas pricer
+	Blocks uint64 `mapstructure:"Blocks"`
+	// Percentile is the percentile of the gas prices paid in the recent blocks used by the
+	// percentile gas pricer
+	Percentile float64 `mapstructure:"Percentile"`
+	// OracleURL is the URL of the gas price oracle with the oracle gas pricer. It must respond to
+	// GET requests with a JSON object with the gas price in wei in the gasPrice field
+	OracleURL string `mapstructure:"OracleURL"`
+	// OracleTimeout is the timeout of the requests to the gas price oracle
+	OracleTimeout types.Duration `mapstructure:"OracleTimeout"`
+}
+
+// ConfigTxReplacement is the configuration of the replacement of the claim txs pending for too long.
+// They are replaced by a tx with the same nonce and bumped fees
+type ConfigTxReplacement struct {
+	// Enabled whether to replace the claim txs stuck in the pool
+	Enabled bool `mapstructure:"Enabled"`
+	// Timeout is the time a claim tx can be pending before being replaced. With GroupingClaims it
+	// must be lower than the GroupingClaims.RetryTimeout, otherwise the tx is considered failed first
+	Timeout types.Duration `mapstructure:"Timeout"`
+	// FeeBumpPercentage is the minimum percentage the fees of the pending tx are increased. The
+	// nodes reject the replacements that don't increase them at least 10%
+	FeeBumpPercentage uint64 `mapstructure:"FeeBumpPercentage"`
+	// MaxBumps is the maximum number of times a claim tx is replaced
+	MaxBumps uint64 `mapstructure:"MaxBumps"`
+}
+
+// ConfigPolicies is the configuration of the claim sponsorship policies. The deposits rejected by
+// them are not claimed and are recorded with the reason, they can still be claimed by the users
+type ConfigPolicies struct {
+	// Tokens are the rules of specific tokens
+	Tokens []ConfigTokenPolicy `mapstructure:"Tokens"`
+	// OnlyListedTokens rejects the asset deposits of the tokens without a rule in Tokens
+	OnlyListedTokens bool `mapstructure:"OnlyListedTokens"`
+	// AllowedDestinationAddresses are the only destination addresses claimed. Empty means any
+	AllowedDestinationAddresses []common.Address `mapstructure:"AllowedDestinationAddresses"`
+	// DeniedDestinationAddresses are the destination addresses never claimed
+	DeniedDestinationAddresses []common.Address `mapstructure:"DeniedDestinationAddresses"`
+	// AllowedMessageContracts are the destination contracts of the messages claimed, in addition
+	// to the messages sent from the AuthorizedClaimMessageAddresses
+	AllowedMessageContracts []common.Address `mapstructure:"AllowedMessageContracts"`
+	// DailyBudgetPerDestination is the maximum fee in wei paid per UTC day by the claims of the same
+	// destination address. The deposits over the budget are checked again the next day. 0 means no limit
+	DailyBudgetPerDestination uint64 `mapstructure:"DailyBudgetPerDestination"`
+}
+
+// ConfigTokenPolicy is the claim policy of a token, identified by its original network and address.
+// The address 0x0000000000000000000000000000000000000000 of the network 0 is the ether
+type ConfigTokenPolicy struct {
+	// OriginalNetwork is the original network of the token
+	OriginalNetwork uint32 `mapstructure:"OriginalNetwork"`
+	// OriginalAddress is the original address of the token
+	OriginalAddress common.Address `mapstructure:"OriginalAddress"`
+	// Disabled rejects all the deposits of the token
+	Disabled bool `mapstructure:"Disabled"`
+	// MinAmount is the minimum amount of the deposits claimed, in the token units. Empty means any
+	MinAmount string `mapstructure:"MinAmount"`
+	// DailyBudget is the maximum fee in wei paid per UTC day by the claims of the token. The deposits
+	// over the budget are checked again the next day. 0 means no limit
+	DailyBudget uint64 `mapstructure:"DailyBudget"`
+}
+
+// ConfigPriority is the configuration of the priority of the pending claims. The priority of a claim is
+// its age in minutes by the AgeWeight, plus its normalized amount by the AmountWeight, plus the bonuses
+// of VIP destinations and messages. With the same weights the claims are sent in creation order
+type ConfigPriority struct {
+	// AgeWeight is the priority a claim gains per minute pending
+	AgeWeight float64 `mapstructure:"AgeWeight"`
+	// AmountWeight is the priority of a normalized unit of the amount of an asset claim
+	AmountWeight float64 `mapstructure:"AmountWeight"`
+	// Tokens are the amounts of the tokens that are a normalized unit. The amounts of the tokens
+	// not listed don't add priority
+	Tokens []ConfigTokenPriority `mapstructure:"Tokens"`
+	// VIPAddresses are the destination addresses whose claims get the VIPBonus
+	VIPAddresses []common.Address `mapstructure:"VIPAddresses"`
+	// VIPBonus is the priority added to the claims of the VIPAddresses
+	VIPBonus float64 `mapstructure:"VIPBonus"`
+	// MessageBonus is the priority added to the message claims. A negative bonus prioritizes the
+	// asset claims
+	MessageBonus float64 `mapstructure:"MessageBonus"`
+	// MaxWaitingTime is the time a claim can be pending before going before any other claim, so the
+	// low priority claims are not starved. 0 disables it
+	MaxWaitingTime types.Duration `mapstructure:"MaxWaitingTime"`
+}
+
+// ConfigTokenPriority is the normalized unit of a token, identified by its original network and address
+type ConfigTokenPriority struct {
+	// OriginalNetwork is the original network of the token
+	OriginalNetwork uint32 `mapstructure:"OriginalNetwork"`
+	// OriginalAddress is the original address of the token
+	OriginalAddress common.Address `mapstructure:"OriginalAddress"`
+	// UnitAmount is the amount of the token, in the token units, that is a normalized unit. For
+	// example the amount worth 1 USD to prioritize the claims by value
+	UnitAmount string `mapstructure:"UnitAmount"`
 }
 
 type ConfigGroupingClaims struct {
-	//Enabled whether to enable this module
+	//Enabled whether to enable this module. It requires a single claim signer
 	Enabled bool `mapstructure:"Enabled"`
 	//FrequencyToProcessCompressedClaims wait time to process compressed claims
 	FrequencyToProcessCompressedClaims types.Duration `mapstructure:"FrequencyToProcessCompressedClaims"`
@@ -0,0 +1,200 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fiwallets/go-ethereum/core/types"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/log"
+)
+
+// minFeeBumpPercentage is the minimum fee bump of a replacement tx accepted by the nodes
+const minFeeBumpPercentage = 10
+
+// errFeesOverCap is returned when the fees of a claim tx exceed the configured caps, so the
+// claim is deferred until they are lower.
+var errFeesOverCap = errors.New("tx fees over the cap")
+
+type FeeSuggester interface {
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// txFees sets the fees of the claim txs sent to a L2 network.
+type txFees struct {
+	cfg       ConfigTxFees
+	client    FeeSuggester
+	gasPricer GasPricer
+	legacy    bool
+}
+
+// newTxFees validates the fees config and returns the fees of the claim txs of the network. The
+// gas price of the legacy txs is the one of the gas pricer, as the fee cap of the EIP-1559 txs with
+// the gaspricer strategy.
+func newTxFees(cfg ConfigTxFees, client FeeSuggester, gasPricer GasPricer, networkID uint32) (*txFees, error) {
+	switch cfg.TipCapStrategy {
+	case TipCapStrategySuggested, TipCapStrategyFixed:
+	default:
+		return nil, fmt.Errorf("invalid TipCapStrategy %q, the valid ones are: %s, %s", cfg.TipCapStrategy, TipCapStrategySuggested, TipCapStrategyFixed)
+	}
+	switch cfg.FeeCapStrategy {
+	case FeeCapStrategyBaseFee, FeeCapStrategyFixed, FeeCapStrategyGasPricer:
+	default:
+		return nil, fmt.Errorf("invalid FeeCapStrategy %q, the valid ones are: %s, %s, %s", cfg.FeeCapStrategy, FeeCapStrategyBaseFee, FeeCapStrategyFixed, FeeCapStrategyGasPricer)
+	}
+	f := &txFees{
+		cfg:       cfg,
+		client:    client,
+		gasPricer: gasPricer,
+	}
+	for _, legacyNetworkID := range cfg.LegacyNetworks {
+		if legacyNetworkID == networkID {
+			f.legacy = true
+		}
+	}
+	if !f.legacy && cfg.FeeCapStrategy != FeeCapStrategyGasPricer && cfg.GasPricer.Type != GasPricerMultiplier {
+		log.Warnf("the %s gas pricer of network %d is only used if the network has no base fee, set the FeeCapStrategy to %s to use it for the EIP-1559 txs",
+			cfg.GasPricer.Type, networkID, FeeCapStrategyGasPricer)
+	}
+	return f, nil
+}
+
+// setFees sets the fees of the monitored tx right before sending it, so they are the most
+// accurate ones. The EIP-1559 fees are set unless the network uses legacy txs. errFeesOverCap is
+// returned if the tx would pay more than the MaxGasPrice or the MaxClaimCost.
+func (f *txFees) setFees(ctx context.Context, mTx *ctmtypes.MonitoredTx) error {
+	if !f.legacy {
+		header, err := f.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get the latest header: %v", err)
+		}
+		if header.BaseFee != nil {
+			return f.setDynamicFees(ctx, mTx, header.BaseFee)
+		}
+	}
+	gasPrice, err := f.gasPricer.GasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	if maxGasPrice := f.maxGasPrice(mTx.Gas); maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+		return fmt.Errorf("%w: gasPrice %s, max gasPrice %s", errFeesOverCap, gasPrice.String(), maxGasPrice.String())
+	}
+	mTx.GasPrice = gasPrice
+	mTx.GasTipCap = nil
+	mTx.GasFeeCap = nil
+	return nil
+}
+
+func (f *txFees) setDynamicFees(ctx context.Context, mTx *ctmtypes.MonitoredTx, baseFee *big.Int) error {
+	tipCap := new(big.Int).SetUint64(f.cfg.FixedTipCap)
+	if f.cfg.TipCapStrategy == TipCapStrategySuggested {
+		suggestedTipCap, err := f.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get suggested gasTipCap: %v", err)
+		}
+		if suggestedTipCap = mulFloat(suggestedTipCap, f.cfg.TipCapMultiplier); suggestedTipCap.Cmp(tipCap) > 0 {
+			tipCap = suggestedTipCap
+		}
+	}
+	var feeCap *big.Int
+	switch f.cfg.FeeCapStrategy {
+	case FeeCapStrategyFixed:
+		feeCap = new(big.Int).SetUint64(f.cfg.FixedFeeCap)
+	case FeeCapStrategyGasPricer:
+		gasPrice, err := f.gasPricer.GasPrice(ctx)
+		if err != nil {
+			return err
+		}
+		feeCap = gasPrice
+	default:
+		feeCap = new(big.Int).Add(mulFloat(baseFee, f.cfg.BaseFeeMultiplier), tipCap)
+	}
+	if maxGasPrice := f.maxGasPrice(mTx.Gas); maxGasPrice != nil {
+		// The tx is deferred if it can't be mined with the current base fee. Otherwise the fee cap
+		// is lowered to never pay more than the caps
+		if price := new(big.Int).Add(baseFee, tipCap); price.Cmp(maxGasPrice) > 0 {
+			return fmt.Errorf("%w: baseFee %s plus gasTipCap %s, max gasPrice %s", errFeesOverCap, baseFee.String(), tipCap.String(), maxGasPrice.String())
+		}
+		if feeCap.Cmp(maxGasPrice) > 0 {
+			feeCap = maxGasPrice
+		}
+	}
+	// The tip can't be higher than the fee cap
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = new(big.Int).Set(feeCap)
+	}
+	mTx.GasPrice = nil
+	mTx.GasTipCap = tipCap
+	mTx.GasFeeCap = feeCap
+	return nil
+}
+
+// setReplacementFees sets the fees of a tx replacing a pending one with the same nonce. They are the
+// current fees but at least the ones of the pending tx bumped by the bumpPercentage, as the nodes
+// reject the replacements that don't bump them. The pending fees of a legacy tx are its gas price.
+// errFeesOverCap is returned if the bumped fees exceed the caps.
+func (f *txFees) setReplacementFees(ctx context.Context, mTx *ctmtypes.MonitoredTx, pendingTipCap, pendingFeeCap *big.Int, bumpPercentage uint64) error {
+	err := f.setFees(ctx, mTx)
+	if err != nil {
+		return err
+	}
+	minTipCap := bumpFee(pendingTipCap, bumpPercentage)
+	minFeeCap := bumpFee(pendingFeeCap, bumpPercentage)
+	var gasPrice *big.Int
+	if mTx.GasFeeCap == nil {
+		// The tip and the fee cap of a legacy tx are its gas price
+		mTx.GasPrice = maxBigInt(mTx.GasPrice, maxBigInt(minTipCap, minFeeCap))
+		gasPrice = mTx.GasPrice
+	} else {
+		mTx.GasTipCap = maxBigInt(mTx.GasTipCap, minTipCap)
+		mTx.GasFeeCap = maxBigInt(mTx.GasFeeCap, maxBigInt(minFeeCap, mTx.GasTipCap))
+		gasPrice = mTx.GasFeeCap
+	}
+	if maxGasPrice := f.maxGasPrice(mTx.Gas); maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+		return fmt.Errorf("%w: bumped gasPrice %s, max gasPrice %s", errFeesOverCap, gasPrice.String(), maxGasPrice.String())
+	}
+	return nil
+}
+
+// bumpFee increases the fee by the percentage, rounding up. It returns nil if the fee is unknown.
+func bumpFee(fee *big.Int, percentage uint64) *big.Int {
+	if fee == nil {
+		return nil
+	}
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percentage)) //nolint:gomnd
+	bumped.Add(bumped, big.NewInt(99))                                      //nolint:gomnd
+	return bumped.Div(bumped, big.NewInt(100))                              //nolint:gomnd
+}
+
+// maxBigInt returns the highest of the values, ignoring the nil ones.
+func maxBigInt(x, y *big.Int) *big.Int {
+	if x == nil || (y != nil && y.Cmp(x) > 0) {
+		return y
+	}
+	return x
+}
+
+// maxGasPrice returns the maximum gas price a tx with the gas limit can pay without exceeding the
+// MaxGasPrice and the MaxClaimCost, or nil if there are no caps.
+func (f *txFees) maxGasPrice(gas uint64) *big.Int {
+	var maxGasPrice *big.Int
+	if f.cfg.MaxGasPrice != 0 {
+		maxGasPrice = new(big.Int).SetUint64(f.cfg.MaxGasPrice)
+	}
+	if f.cfg.MaxClaimCost != 0 && gas != 0 {
+		maxCostGasPrice := new(big.Int).SetUint64(f.cfg.MaxClaimCost / gas)
+		if maxGasPrice == nil || maxCostGasPrice.Cmp(maxGasPrice) < 0 {
+			maxGasPrice = maxCostGasPrice
+		}
+	}
+	return maxGasPrice
+}
+
+// mulFloat multiplies x by m, rounding down.
+func mulFloat(x *big.Int, m float64) *big.Int {
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(x), big.NewFloat(m)).Int(nil)
+	return result
+}
@@ -0,0 +1,291 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/go-ethereum/core/types"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTxFeesConfig() ConfigTxFees {
+	return ConfigTxFees{
+		LegacyNetworks:    []uint32{2},
+		TipCapStrategy:    TipCapStrategySuggested,
+		TipCapMultiplier:  1.5,
+		FixedTipCap:       100,
+		FeeCapStrategy:    FeeCapStrategyBaseFee,
+		BaseFeeMultiplier: 2,
+	}
+}
+
+func TestNewTxFees(t *testing.T) {
+	cfg := newTestTxFeesConfig()
+	fees, err := newTxFees(cfg, nil, nil, 1)
+	require.NoError(t, err)
+	require.False(t, fees.legacy)
+	fees, err = newTxFees(cfg, nil, nil, 2)
+	require.NoError(t, err)
+	require.True(t, fees.legacy)
+
+	cfg.TipCapStrategy = "invalid"
+	_, err = newTxFees(cfg, nil, nil, 1)
+	require.Error(t, err)
+	cfg = newTestTxFeesConfig()
+	cfg.FeeCapStrategy = ""
+	_, err = newTxFees(cfg, nil, nil, 1)
+	require.Error(t, err)
+}
+
+func TestSetFees(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("dynamic fees", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		fees, err := newTxFees(newTestTxFeesConfig(), client, nil, 1)
+		require.NoError(t, err)
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		mTx := ctmtypes.MonitoredTx{GasPrice: big.NewInt(1)}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Nil(t, mTx.GasPrice)
+		require.Equal(t, big.NewInt(300), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(2300), mTx.GasFeeCap)
+		require.Equal(t, uint8(types.DynamicFeeTxType), mTx.Tx().Type())
+
+		// The fixed tip is the minimum tip
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(10), nil).Once()
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Equal(t, big.NewInt(100), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(2100), mTx.GasFeeCap)
+	})
+
+	t.Run("fixed fees", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		cfg := newTestTxFeesConfig()
+		cfg.TipCapStrategy = TipCapStrategyFixed
+		cfg.FeeCapStrategy = FeeCapStrategyFixed
+		cfg.FixedFeeCap = 50
+		fees, err := newTxFees(cfg, client, nil, 1)
+		require.NoError(t, err)
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		// The tip is limited by the fee cap
+		require.Equal(t, big.NewInt(50), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(50), mTx.GasFeeCap)
+	})
+
+	t.Run("gas pricer fee cap", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		cfg := newTestTxFeesConfig()
+		cfg.FeeCapStrategy = FeeCapStrategyGasPricer
+		fees, err := newTxFees(cfg, client, &fixedGasPricer{gasPrice: big.NewInt(1500)}, 1)
+		require.NoError(t, err)
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Nil(t, mTx.GasPrice)
+		require.Equal(t, big.NewInt(300), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(1500), mTx.GasFeeCap)
+	})
+
+	t.Run("legacy network", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		fees, err := newTxFees(newTestTxFeesConfig(), client, &fixedGasPricer{gasPrice: big.NewInt(70)}, 2)
+		require.NoError(t, err)
+		mTx := ctmtypes.MonitoredTx{GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(1)}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Equal(t, big.NewInt(70), mTx.GasPrice)
+		require.Nil(t, mTx.GasTipCap)
+		require.Nil(t, mTx.GasFeeCap)
+		require.Equal(t, uint8(types.LegacyTxType), mTx.Tx().Type())
+	})
+
+	t.Run("network without base fee", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		fees, err := newTxFees(newTestTxFeesConfig(), client, &fixedGasPricer{gasPrice: big.NewInt(70)}, 1)
+		require.NoError(t, err)
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{}, nil).Once()
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Equal(t, big.NewInt(70), mTx.GasPrice)
+		require.Nil(t, mTx.GasFeeCap)
+	})
+	t.Run("max gas price", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		cfg := newTestTxFeesConfig()
+		cfg.MaxGasPrice = 2000
+		fees, err := newTxFees(cfg, client, &fixedGasPricer{gasPrice: big.NewInt(2001)}, 1)
+		require.NoError(t, err)
+
+		// The fee cap is lowered to the max gas price
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Equal(t, big.NewInt(300), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(2000), mTx.GasFeeCap)
+
+		// The tx is deferred if the base fee plus the tip exceeds the max gas price
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1800)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		mTx = ctmtypes.MonitoredTx{}
+		require.ErrorIs(t, fees.setFees(ctx, &mTx), errFeesOverCap)
+		require.Nil(t, mTx.GasFeeCap)
+
+		// The legacy tx is deferred if the gas price exceeds the max gas price
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{}, nil).Once()
+		require.ErrorIs(t, fees.setFees(ctx, &mTx), errFeesOverCap)
+		require.Nil(t, mTx.GasPrice)
+	})
+
+	t.Run("max claim cost", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		cfg := newTestTxFeesConfig()
+		cfg.MaxGasPrice = 2000
+		cfg.MaxClaimCost = 150000
+		fees, err := newTxFees(cfg, client, &fixedGasPricer{gasPrice: big.NewInt(1500)}, 2)
+		require.NoError(t, err)
+
+		mTx := ctmtypes.MonitoredTx{Gas: 100}
+		require.NoError(t, fees.setFees(ctx, &mTx))
+		require.Equal(t, big.NewInt(1500), mTx.GasPrice)
+
+		// The claim cost is lower than the max gas price for this gas
+		mTx = ctmtypes.MonitoredTx{Gas: 101}
+		require.ErrorIs(t, fees.setFees(ctx, &mTx), errFeesOverCap)
+	})
+}
+
+func TestSetReplacementFees(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("dynamic fees", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		fees, err := newTxFees(newTestTxFeesConfig(), client, nil, 1)
+		require.NoError(t, err)
+
+		// The pending fees are bumped when the current ones are lower
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setReplacementFees(ctx, &mTx, big.NewInt(1000), big.NewInt(3001), 10))
+		require.Equal(t, big.NewInt(1100), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(3302), mTx.GasFeeCap)
+
+		// The current fees are used when they are higher
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		require.NoError(t, fees.setReplacementFees(ctx, &mTx, big.NewInt(100), big.NewInt(1000), 10))
+		require.Equal(t, big.NewInt(300), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(2300), mTx.GasFeeCap)
+
+		// A pending legacy tx is replaced bumping its gas price
+		client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+		client.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(200), nil).Once()
+		require.NoError(t, fees.setReplacementFees(ctx, &mTx, big.NewInt(5000), big.NewInt(5000), 20))
+		require.Equal(t, big.NewInt(6000), mTx.GasTipCap)
+		require.Equal(t, big.NewInt(6000), mTx.GasFeeCap)
+	})
+
+	t.Run("legacy network", func(t *testing.T) {
+		client := mock_txcompressor.NewFeeSuggester(t)
+		cfg := newTestTxFeesConfig()
+		cfg.MaxGasPrice = 100
+		fees, err := newTxFees(cfg, client, &fixedGasPricer{gasPrice: big.NewInt(70)}, 2)
+		require.NoError(t, err)
+
+		mTx := ctmtypes.MonitoredTx{}
+		require.NoError(t, fees.setReplacementFees(ctx, &mTx, big.NewInt(80), big.NewInt(80), 10))
+		require.Equal(t, big.NewInt(88), mTx.GasPrice)
+
+		// The pending fees are unknown
+		require.NoError(t, fees.setReplacementFees(ctx, &mTx, nil, nil, 10))
+		require.Equal(t, big.NewInt(70), mTx.GasPrice)
+
+		// The bumped fees exceed the caps
+		require.ErrorIs(t, fees.setReplacementFees(ctx, &mTx, big.NewInt(91), big.NewInt(91), 10), errFeesOverCap)
+	})
+}
+
+func TestBumpFee(t *testing.T) {
+	require.Nil(t, bumpFee(nil, 10))
+	require.Equal(t, big.NewInt(110), bumpFee(big.NewInt(100), 10))
+	// The bumped fee is rounded up
+	require.Equal(t, big.NewInt(12), bumpFee(big.NewInt(10), 12))
+	require.Zero(t, bumpFee(big.NewInt(0), 10).Sign())
+}
+
+func TestSendClaimsFees(t *testing.T) {
+	ctx := context.Background()
+	client := mock_txcompressor.NewFeeSuggester(t)
+	ethermanMock := mock_txcompressor.NewEthermanI(t)
+	bridge := mock_txcompressor.NewBridgeClaimedCaller(t)
+	cfg := newTestTxFeesConfig()
+	cfg.TipCapStrategy = TipCapStrategyFixed
+	cfg.MaxGasPrice = 1000
+	fees, err := newTxFees(cfg, client, nil, 1)
+	require.NoError(t, err)
+	claimed, err := NewClaimedChecker(bridge)
+	require.NoError(t, err)
+	tm := &MonitorCompressedTxs{auth: &bind.TransactOpts{}, etherMan: ethermanMock, rollupID: 1, fees: fees, claimed: claimed}
+	group := &ctmtypes.MonitoredTxGroup{
+		DbEntry: ctmtypes.MonitoredTxGroupDBEntry{GroupID: 1, Status: ctmtypes.MonitoredTxGroupStatusCreated, CompressedTxData: []byte{0x01}},
+		Txs:     []ctmtypes.MonitoredTx{{DepositID: 1, Data: claimAssetData(t, etherman.GenerateGlobalIndex(true, 0, 1))}},
+	}
+	pendingTxs := &PendingTxs{GroupTx: map[uint64]*ctmtypes.MonitoredTxGroup{1: group}}
+	bridge.EXPECT().IsClaimed(mock.Anything, uint32(1), uint32(0)).Return(false, nil).Times(2)
+	estimatedTx := types.NewTx(&types.DynamicFeeTx{Gas: 100000})
+	isEstimation := func(auth *bind.TransactOpts) bool { return auth.NoSend }
+
+	// The claim tx is deferred while the fees are over the caps
+	ethermanMock.EXPECT().SendCompressedClaims(mock.MatchedBy(isEstimation), group.DbEntry.CompressedTxData).Return(estimatedTx, nil).Once()
+	client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(1000)}, nil).Once()
+	require.NoError(t, tm.SendClaims(ctx, pendingTxs, false))
+	require.Equal(t, ctmtypes.MonitoredTxGroupStatusCreated, group.DbEntry.Status)
+	require.True(t, group.DbEntry.IsClaimTxHistoryEmpty())
+	require.Contains(t, group.DbEntry.LastLog, "deferred")
+
+	// The claim tx is sent with the fees of the regular claims
+	ethermanMock.EXPECT().SendCompressedClaims(mock.MatchedBy(isEstimation), group.DbEntry.CompressedTxData).Return(estimatedTx, nil).Once()
+	client.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(500)}, nil).Once()
+	ethermanMock.EXPECT().SendCompressedClaims(mock.MatchedBy(func(auth *bind.TransactOpts) bool {
+		return !auth.NoSend && auth.GasPrice == nil && auth.GasTipCap.Cmp(big.NewInt(100)) == 0 && auth.GasFeeCap.Cmp(big.NewInt(1000)) == 0
+	}), group.DbEntry.CompressedTxData).Return(estimatedTx, nil).Once()
+	require.NoError(t, tm.SendClaims(ctx, pendingTxs, false))
+	require.Equal(t, ctmtypes.MonitoredTxGroupStatusClaiming, group.DbEntry.Status)
+	require.False(t, group.DbEntry.IsClaimTxHistoryEmpty())
+}
+
+func TestSendClaimsLegacyFees(t *testing.T) {
+	ctx := context.Background()
+	ethermanMock := mock_txcompressor.NewEthermanI(t)
+	bridge := mock_txcompressor.NewBridgeClaimedCaller(t)
+	// The compressed claims of the legacy networks are sent with the gas price of the gas pricer
+	fees, err := newTxFees(newTestTxFeesConfig(), mock_txcompressor.NewFeeSuggester(t), &fixedGasPricer{gasPrice: big.NewInt(70)}, 2)
+	require.NoError(t, err)
+	claimed, err := NewClaimedChecker(bridge)
+	require.NoError(t, err)
+	tm := &MonitorCompressedTxs{auth: &bind.TransactOpts{}, etherMan: ethermanMock, rollupID: 2, fees: fees, claimed: claimed}
+	group := &ctmtypes.MonitoredTxGroup{
+		DbEntry: ctmtypes.MonitoredTxGroupDBEntry{GroupID: 1, Status: ctmtypes.MonitoredTxGroupStatusCreated, CompressedTxData: []byte{0x01}},
+		Txs:     []ctmtypes.MonitoredTx{{DepositID: 1, Data: claimAssetData(t, etherman.GenerateGlobalIndex(true, 0, 1))}},
+	}
+	bridge.EXPECT().IsClaimed(mock.Anything, uint32(1), uint32(0)).Return(false, nil).Once()
+	estimatedTx := types.NewTx(&types.LegacyTx{Gas: 100000})
+	ethermanMock.EXPECT().SendCompressedClaims(mock.MatchedBy(func(auth *bind.TransactOpts) bool { return auth.NoSend }), group.DbEntry.CompressedTxData).Return(estimatedTx, nil).Once()
+	ethermanMock.EXPECT().SendCompressedClaims(mock.MatchedBy(func(auth *bind.TransactOpts) bool {
+		return !auth.NoSend && auth.GasPrice.Cmp(big.NewInt(70)) == 0 && auth.GasTipCap == nil && auth.GasFeeCap == nil
+	}), group.DbEntry.CompressedTxData).Return(estimatedTx, nil).Once()
+	require.NoError(t, tm.SendClaims(ctx, &PendingTxs{GroupTx: map[uint64]*ctmtypes.MonitoredTxGroup{1: group}}, false))
+	require.Equal(t, ctmtypes.MonitoredTxGroupStatusClaiming, group.DbEntry.Status)
+}
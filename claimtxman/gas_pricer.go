@@ -0,0 +1,184 @@
+package claimtxman
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fiwallets/go-ethereum/core/types"
+)
+
+const (
+	// GasPricerFixed uses the FixedGasPrice
+	GasPricerFixed = "fixed"
+	// GasPricerMultiplier uses the gas price suggested by the node multiplied by the Multiplier
+	GasPricerMultiplier = "multiplier"
+	// GasPricerPercentile uses a percentile of the gas prices paid in the recent blocks
+	GasPricerPercentile = "percentile"
+	// GasPricerOracle uses the gas price returned by an external oracle
+	GasPricerOracle = "oracle"
+
+	maxOracleResponseSize = 1 << 20
+)
+
+// GasPricer suggests the gas price of the legacy claim txs.
+type GasPricer interface {
+	GasPrice(ctx context.Context) (*big.Int, error)
+}
+
+type GasPricerClient interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// NewGasPricer creates the gas pricer of the configured type.
+func NewGasPricer(cfg ConfigGasPricer, client GasPricerClient) (GasPricer, error) {
+	switch cfg.Type {
+	case GasPricerFixed:
+		return &fixedGasPricer{gasPrice: new(big.Int).SetUint64(cfg.FixedGasPrice)}, nil
+	case GasPricerMultiplier:
+		return &multiplierGasPricer{client: client, multiplier: cfg.Multiplier}, nil
+	case GasPricerPercentile:
+		if cfg.Blocks == 0 || cfg.Percentile <= 0 || cfg.Percentile > 100 {
+			return nil, fmt.Errorf("invalid percentile gas pricer config, Blocks must be positive and Percentile in (0, 100]: Blocks %d, Percentile %v", cfg.Blocks, cfg.Percentile)
+		}
+		return &percentileGasPricer{client: client, blocks: cfg.Blocks, percentile: cfg.Percentile}, nil
+	case GasPricerOracle:
+		if !strings.HasPrefix(cfg.OracleURL, "http://") && !strings.HasPrefix(cfg.OracleURL, "https://") {
+			return nil, fmt.Errorf("invalid gas price oracle URL '%s'", cfg.OracleURL)
+		}
+		return &oracleGasPricer{
+			url:        cfg.OracleURL,
+			httpClient: &http.Client{Timeout: cfg.OracleTimeout.Duration},
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid gas pricer type %q, the valid ones are: %s, %s, %s, %s", cfg.Type, GasPricerFixed, GasPricerMultiplier, GasPricerPercentile, GasPricerOracle)
+}
+
+// fixedGasPricer always returns the same gas price.
+type fixedGasPricer struct {
+	gasPrice *big.Int
+}
+
+func (p *fixedGasPricer) GasPrice(ctx context.Context) (*big.Int, error) {
+	return new(big.Int).Set(p.gasPrice), nil
+}
+
+// multiplierGasPricer multiplies the gas price suggested by the node.
+type multiplierGasPricer struct {
+	client     GasPricerClient
+	multiplier float64
+}
+
+func (p *multiplierGasPricer) GasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, err := p.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggested gasPrice: %v", err)
+	}
+	return mulFloat(gasPrice, p.multiplier), nil
+}
+
+// percentileGasPricer returns a percentile of the gas prices paid by the txs of the recent
+// blocks. The gas price is computed once per block.
+type percentileGasPricer struct {
+	client     GasPricerClient
+	blocks     uint64
+	percentile float64
+
+	mu        sync.Mutex
+	lastBlock uint64
+	gasPrice  *big.Int
+}
+
+func (p *percentileGasPricer) GasPrice(ctx context.Context) (*big.Int, error) {
+	head, err := p.client.BlockByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest block: %v", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.gasPrice != nil && p.lastBlock == head.NumberU64() {
+		return new(big.Int).Set(p.gasPrice), nil
+	}
+
+	gasPrices := blockGasPrices(head)
+	for i := uint64(1); i < p.blocks && i <= head.NumberU64(); i++ {
+		block, err := p.client.BlockByNumber(ctx, new(big.Int).SetUint64(head.NumberU64()-i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the block %d: %v", head.NumberU64()-i, err)
+		}
+		gasPrices = append(gasPrices, blockGasPrices(block)...)
+	}
+	var gasPrice *big.Int
+	if len(gasPrices) == 0 {
+		// Without txs in the recent blocks the node suggestion is used
+		if gasPrice, err = p.client.SuggestGasPrice(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get suggested gasPrice: %v", err)
+		}
+	} else {
+		sort.Slice(gasPrices, func(i, j int) bool { return gasPrices[i].Cmp(gasPrices[j]) < 0 })
+		idx := int(float64(len(gasPrices)-1) * p.percentile / 100) //nolint:gomnd
+		gasPrice = gasPrices[idx]
+	}
+	p.lastBlock = head.NumberU64()
+	p.gasPrice = gasPrice
+	return new(big.Int).Set(gasPrice), nil
+}
+
+// blockGasPrices returns the gas prices paid by the txs of the block.
+func blockGasPrices(block *types.Block) []*big.Int {
+	gasPrices := make([]*big.Int, 0, len(block.Transactions()))
+	baseFee := block.BaseFee()
+	for _, tx := range block.Transactions() {
+		if baseFee == nil {
+			gasPrices = append(gasPrices, tx.GasPrice())
+			continue
+		}
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			continue
+		}
+		gasPrices = append(gasPrices, new(big.Int).Add(baseFee, tip))
+	}
+	return gasPrices
+}
+
+// oracleGasPricer gets the gas price from an external oracle. The oracle responds to GET
+// requests with a JSON object with the gas price in wei in the gasPrice field, as a number or
+// a decimal string.
+type oracleGasPricer struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (p *oracleGasPricer) GasPrice(ctx context.Context) (*big.Int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request the gas price oracle: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the gas price oracle responded with status %d", res.StatusCode)
+	}
+	var body struct {
+		GasPrice json.RawMessage `json:"gasPrice"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxOracleResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode the gas price oracle response: %v", err)
+	}
+	gasPrice, ok := new(big.Int).SetString(strings.Trim(string(body.GasPrice), `"`), 10) //nolint:gomnd
+	if !ok || gasPrice.Sign() < 0 {
+		return nil, fmt.Errorf("invalid gas price returned by the oracle: %s", string(body.GasPrice))
+	}
+	return gasPrice, nil
+}
@@ -0,0 +1,146 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	ethtypes "github.com/fiwallets/go-ethereum/core/types"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlock(number int64, baseFee *big.Int, txs ...ethtypes.TxData) *ethtypes.Block {
+	transactions := make([]*ethtypes.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		transactions = append(transactions, ethtypes.NewTx(tx))
+	}
+	header := &ethtypes.Header{Number: big.NewInt(number), BaseFee: baseFee}
+	return ethtypes.NewBlockWithHeader(header).WithBody(transactions, nil)
+}
+
+func TestNewGasPricer(t *testing.T) {
+	_, err := NewGasPricer(ConfigGasPricer{Type: GasPricerFixed}, nil)
+	require.NoError(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerMultiplier}, nil)
+	require.NoError(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerPercentile, Blocks: 10, Percentile: 50}, nil)
+	require.NoError(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerPercentile, Blocks: 10, Percentile: 101}, nil)
+	require.Error(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerPercentile, Percentile: 50}, nil)
+	require.Error(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerOracle, OracleURL: "http://localhost:8080/gasprice"}, nil)
+	require.NoError(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: GasPricerOracle}, nil)
+	require.Error(t, err)
+	_, err = NewGasPricer(ConfigGasPricer{Type: "invalid"}, nil)
+	require.Error(t, err)
+}
+
+func TestFixedGasPricer(t *testing.T) {
+	p, err := NewGasPricer(ConfigGasPricer{Type: GasPricerFixed, FixedGasPrice: 42}, nil)
+	require.NoError(t, err)
+	gasPrice, err := p.GasPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(42), gasPrice)
+}
+
+func TestMultiplierGasPricer(t *testing.T) {
+	client := mock_txcompressor.NewGasPricerClient(t)
+	p, err := NewGasPricer(ConfigGasPricer{Type: GasPricerMultiplier, Multiplier: 2.5}, client)
+	require.NoError(t, err)
+	client.EXPECT().SuggestGasPrice(mock.Anything).Return(big.NewInt(10), nil).Once()
+	gasPrice, err := p.GasPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(25), gasPrice)
+}
+
+func TestPercentileGasPricer(t *testing.T) {
+	ctx := context.Background()
+	client := mock_txcompressor.NewGasPricerClient(t)
+	p, err := NewGasPricer(ConfigGasPricer{Type: GasPricerPercentile, Blocks: 2, Percentile: 50}, client)
+	require.NoError(t, err)
+
+	// The legacy and the EIP-1559 txs of the recent blocks are considered
+	head := newTestBlock(5, big.NewInt(100),
+		&ethtypes.LegacyTx{GasPrice: big.NewInt(300)},
+		&ethtypes.DynamicFeeTx{GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(1000)},
+		&ethtypes.DynamicFeeTx{GasTipCap: big.NewInt(5), GasFeeCap: big.NewInt(90)},
+	)
+	client.EXPECT().BlockByNumber(mock.Anything, (*big.Int)(nil)).Return(head, nil).Once()
+	client.EXPECT().BlockByNumber(mock.Anything, big.NewInt(4)).Return(newTestBlock(4, big.NewInt(100),
+		&ethtypes.LegacyTx{GasPrice: big.NewInt(200)},
+		&ethtypes.LegacyTx{GasPrice: big.NewInt(400)},
+		&ethtypes.LegacyTx{GasPrice: big.NewInt(50)},
+	), nil).Once()
+	gasPrice, err := p.GasPrice(ctx)
+	require.NoError(t, err)
+	// Prices paid: 110, 200, 300, 400 and the underpriced txs are ignored
+	require.Equal(t, big.NewInt(200), gasPrice)
+
+	// The gas price is computed once per block
+	client.EXPECT().BlockByNumber(mock.Anything, (*big.Int)(nil)).Return(head, nil).Once()
+	gasPrice, err = p.GasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), gasPrice)
+
+	// Without txs the gas price suggested by the node is used
+	client.EXPECT().BlockByNumber(mock.Anything, (*big.Int)(nil)).Return(newTestBlock(6, nil), nil).Once()
+	client.EXPECT().BlockByNumber(mock.Anything, big.NewInt(5)).Return(newTestBlock(5, nil), nil).Once()
+	client.EXPECT().SuggestGasPrice(mock.Anything).Return(big.NewInt(7), nil).Once()
+	gasPrice, err = p.GasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), gasPrice)
+}
+
+func TestOracleGasPricer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		response = `{"gasPrice": 1000000000}`
+		status   = http.StatusOK
+	)
+	respond := func(newStatus int, newResponse string) {
+		mu.Lock()
+		defer mu.Unlock()
+		status, response = newStatus, newResponse
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+	p, err := NewGasPricer(ConfigGasPricer{Type: GasPricerOracle, OracleURL: srv.URL, OracleTimeout: types.NewDuration(time.Second)}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	gasPrice, err := p.GasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000000000), gasPrice)
+
+	respond(http.StatusOK, `{"gasPrice": "123456789012345678901234567890"}`)
+	gasPrice, err = p.GasPrice(ctx)
+	require.NoError(t, err)
+	expected, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.Equal(t, expected, gasPrice)
+
+	respond(http.StatusOK, `{"gasPrice": "0x10"}`)
+	_, err = p.GasPrice(ctx)
+	require.Error(t, err)
+
+	respond(http.StatusOK, `{}`)
+	_, err = p.GasPrice(ctx)
+	require.Error(t, err)
+
+	respond(http.StatusInternalServerError, `{"gasPrice": 1}`)
+	_, err = p.GasPrice(ctx)
+	require.Error(t, err)
+}
@@ -9,18 +9,20 @@ import (
 
 type GroupsTrigger struct {
 	Cfg ConfigGroupingClaims
+	// Priority orders the candidates to choose the claims of the group, nil keeps their order
+	Priority *ClaimPrioritizer
 }
 
-func NewGroupsTrigger(cfg ConfigGroupingClaims) *GroupsTrigger {
-	return &GroupsTrigger{Cfg: cfg}
+func NewGroupsTrigger(cfg ConfigGroupingClaims, priority *ClaimPrioritizer) *GroupsTrigger {
+	return &GroupsTrigger{Cfg: cfg, Priority: priority}
 }
 
 func (t *GroupsTrigger) ChooseTxs(now time.Time, TxCandidatesForGroup []ctmtypes.MonitoredTx) []ctmtypes.MonitoredTx {
 	if t.isRetainedPeriodSurpassed(now, TxCandidatesForGroup) {
-		return t.chooseGroupTx(TxCandidatesForGroup)
+		return t.chooseGroupTx(now, TxCandidatesForGroup)
 	}
 	if len(TxCandidatesForGroup) >= t.Cfg.TriggerNumberOfClaims {
-		return t.chooseGroupTx(TxCandidatesForGroup)
+		return t.chooseGroupTx(now, TxCandidatesForGroup)
 	}
 	return nil
 }
@@ -36,9 +38,16 @@ func (t *GroupsTrigger) isRetainedPeriodSurpassed(now time.Time, TxCandidatesFor
 	return false
 }
 
-func (t *GroupsTrigger) chooseGroupTx(TxCandidatesForGroup []ctmtypes.MonitoredTx) []ctmtypes.MonitoredTx {
+// chooseGroupTx chooses the candidates with the highest priority, up to the maximum number of claims per group
+func (t *GroupsTrigger) chooseGroupTx(now time.Time, TxCandidatesForGroup []ctmtypes.MonitoredTx) []ctmtypes.MonitoredTx {
+	candidates := TxCandidatesForGroup
+	if t.Priority != nil {
+		// the candidates are sorted in a copy to not reorder the pending txs
+		candidates = append([]ctmtypes.MonitoredTx(nil), TxCandidatesForGroup...)
+		t.Priority.Sort(now, candidates)
+	}
 	group := []ctmtypes.MonitoredTx{}
-	for _, tx := range TxCandidatesForGroup {
+	for _, tx := range candidates {
 		group = append(group, tx)
 		if len(group) == t.Cfg.MaxNumberOfClaimsPerGroup {
 			break
@@ -19,6 +19,7 @@ type StorageInterface interface {
 	AddClaimTx(ctx context.Context, mTx types.MonitoredTx, dbTx pgx.Tx) error
 	UpdateClaimTx(ctx context.Context, mTx types.MonitoredTx, dbTx pgx.Tx) error
 	GetClaimTxsByStatus(ctx context.Context, statuses []types.MonitoredTxStatus, rollupID uint32, dbTx pgx.Tx) ([]types.MonitoredTx, error)
+	GetClaimTxsCountByStatus(ctx context.Context, rollupID uint32, dbTx pgx.Tx) (map[types.MonitoredTxStatus]uint64, error)
 	// atomic
 	Rollback(ctx context.Context, dbTx pgx.Tx) error
 	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
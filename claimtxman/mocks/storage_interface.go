@@ -301,6 +301,66 @@ func (_c *StorageInterface_GetClaimTxsByStatus_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetClaimTxsCountByStatus provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageInterface) GetClaimTxsCountByStatus(ctx context.Context, rollupID uint32, dbTx pgx.Tx) (map[types.MonitoredTxStatus]uint64, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClaimTxsCountByStatus")
+	}
+
+	var r0 map[types.MonitoredTxStatus]uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) (map[types.MonitoredTxStatus]uint64, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) map[types.MonitoredTxStatus]uint64); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.MonitoredTxStatus]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetClaimTxsCountByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClaimTxsCountByStatus'
+type StorageInterface_GetClaimTxsCountByStatus_Call struct {
+	*mock.Call
+}
+
+// GetClaimTxsCountByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint32
+//   - dbTx pgx.Tx
+func (_e *StorageInterface_Expecter) GetClaimTxsCountByStatus(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageInterface_GetClaimTxsCountByStatus_Call {
+	return &StorageInterface_GetClaimTxsCountByStatus_Call{Call: _e.mock.On("GetClaimTxsCountByStatus", ctx, rollupID, dbTx)}
+}
+
+func (_c *StorageInterface_GetClaimTxsCountByStatus_Call) Run(run func(ctx context.Context, rollupID uint32, dbTx pgx.Tx)) *StorageInterface_GetClaimTxsCountByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetClaimTxsCountByStatus_Call) Return(_a0 map[types.MonitoredTxStatus]uint64, _a1 error) *StorageInterface_GetClaimTxsCountByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetClaimTxsCountByStatus_Call) RunAndReturn(run func(context.Context, uint32, pgx.Tx) (map[types.MonitoredTxStatus]uint64, error)) *StorageInterface_GetClaimTxsCountByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDepositsFromOtherL2ToClaim provides a mock function with given fields: ctx, destinationNetwork, dbTx
 func (_m *StorageInterface) GetDepositsFromOtherL2ToClaim(ctx context.Context, destinationNetwork uint32, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	ret := _m.Called(ctx, destinationNetwork, dbTx)
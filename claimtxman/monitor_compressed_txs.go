@@ -2,11 +2,15 @@ package claimtxman
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/etherman/smartcontracts/claimcompressor"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
 	"github.com/fiwallets/go-ethereum/common"
@@ -42,7 +46,6 @@ type MonitorCompressedTxs struct {
 	// client is the ethereum client
 	l2Node                *utils.Client
 	cfg                   Config
-	nonceCache            *NonceCache
 	auth                  *bind.TransactOpts
 	etherMan              EthermanI
 	compressClaimComposer *ComposeCompressClaim
@@ -50,18 +53,22 @@ type MonitorCompressedTxs struct {
 	triggerGroups         *GroupsTrigger
 	gasOffset             uint64
 	rollupID              uint32
+	fees                  *txFees
+	claimed               *ClaimedChecker
 }
 
 func NewMonitorCompressedTxs(ctx context.Context,
 	storage StorageCompressedInterface,
 	l2Node *utils.Client,
 	cfg Config,
-	nonceCache *NonceCache,
 	auth *bind.TransactOpts,
 	etherMan EthermanI,
 	timeProvider utils.TimeProvider,
 	gasOffset uint64,
-	rollupID uint32) *MonitorCompressedTxs {
+	rollupID uint32,
+	fees *txFees,
+	claimed *ClaimedChecker,
+	priority *ClaimPrioritizer) *MonitorCompressedTxs {
 	composer, err := NewComposeCompressClaim()
 	if err != nil {
 		log.Fatal("failed to create ComposeCompressClaim: %v", err)
@@ -71,17 +78,26 @@ func NewMonitorCompressedTxs(ctx context.Context,
 		ctx:                   ctx,
 		l2Node:                l2Node,
 		cfg:                   cfg,
-		nonceCache:            nonceCache,
 		auth:                  auth,
 		etherMan:              etherMan,
 		compressClaimComposer: composer,
 		timeProvider:          timeProvider,
-		triggerGroups:         NewGroupsTrigger(cfg.GroupingClaims),
+		triggerGroups:         NewGroupsTrigger(cfg.GroupingClaims, priority),
 		gasOffset:             gasOffset,
 		rollupID:              rollupID,
+		fees:                  fees,
+		claimed:               claimed,
 	}
 }
 
+func getDepositIDs(txs []ctmtypes.MonitoredTx) []uint64 {
+	ids := make([]uint64, len(txs))
+	for i := range txs {
+		ids[i] = txs[i].DepositID
+	}
+	return ids
+}
+
 func getGroupsIds(txs []ctmtypes.MonitoredTx) []uint64 {
 	tmp := make(map[uint64]struct{})
 	for _, tx := range txs {
@@ -119,7 +135,10 @@ func (tm *MonitorCompressedTxs) getPendingTxs(ctx context.Context, dbTx pgx.Tx)
 }
 
 // monitorTxs process all pending monitored tx
-func (tm *MonitorCompressedTxs) MonitorTxs(ctx context.Context) error {
+func (tm *MonitorCompressedTxs) MonitorTxs(ctx context.Context) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "claimtxman.monitorCompressedTxs", tracing.RollupID(tm.rollupID))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	dbTx, err := tm.storage.BeginDBTransaction(ctx)
 	if err != nil {
 		return err
@@ -172,7 +191,7 @@ func (tm *MonitorCompressedTxs) internalMonitorTxs(ctx context.Context, dbTx pgx
 
 func (tm *MonitorCompressedTxs) Process(ctx context.Context, pendingTxs *PendingTxs) error {
 	// SendCompressClaims for each group
-	err := tm.SendClaims(pendingTxs, false)
+	err := tm.SendClaims(ctx, pendingTxs, false)
 	if err != nil {
 		return err
 	}
@@ -186,7 +205,7 @@ func (tm *MonitorCompressedTxs) Process(ctx context.Context, pendingTxs *Pending
 		return err
 	}
 	// Try to create new groups to claims
-	err = tm.createNewGroups(pendingTxs)
+	err = tm.createNewGroups(ctx, pendingTxs)
 	if err != nil {
 		return err
 	}
@@ -206,6 +225,7 @@ func (tm *MonitorCompressedTxs) OnFinishClaimGroupTxSuccessful(group *ctmtypes.M
 		log.Infof("group_id:%d , confirmed deposit_id:%d", group.DbEntry.GroupID, group.Txs[i].DepositID)
 		group.Txs[i].Status = ctmtypes.MonitoredTxStatusConfirmed
 	}
+	group.AssignTxsCost()
 }
 
 func (tm *MonitorCompressedTxs) OnFailGroup(group *ctmtypes.MonitoredTxGroup) {
@@ -215,6 +235,33 @@ func (tm *MonitorCompressedTxs) OnFailGroup(group *ctmtypes.MonitoredTxGroup) {
 		log.Infof("group_id:%d , failed deposit_id:%d", group.DbEntry.GroupID, group.Txs[i].DepositID)
 		group.Txs[i].Status = ctmtypes.MonitoredTxStatusFailed
 	}
+	group.AssignTxsCost()
+}
+
+// OnGroupClaimedExternally is called when all the deposits of the group were claimed by other txs
+func (tm *MonitorCompressedTxs) OnGroupClaimedExternally(group *ctmtypes.MonitoredTxGroup) {
+	msg := fmt.Sprintf("group_id:%d , all the deposits were claimed by other txs", group.DbEntry.GroupID)
+	log.Info(msg)
+	group.DbEntry.LastLog = msg
+	group.DbEntry.Status = ctmtypes.MonitoredTxGroupStatusConfirmed
+	for i := 0; i < len(group.Txs); i++ {
+		log.Infof("group_id:%d , claimed externally deposit_id:%d", group.DbEntry.GroupID, group.Txs[i].DepositID)
+		group.Txs[i].Status = ctmtypes.MonitoredTxStatusClaimedExternally
+		metrics.ClaimedExternally(tm.rollupID)
+	}
+	group.AssignTxsCost()
+}
+
+// isGroupClaimedExternally returns whether all the deposits of the group are already claimed on-chain.
+// The compressed claims of a group with some deposits claimed are still sent for the rest of them.
+func (tm *MonitorCompressedTxs) isGroupClaimedExternally(ctx context.Context, group *ctmtypes.MonitoredTxGroup) (bool, error) {
+	for i := range group.Txs {
+		claimed, err := tm.claimed.IsClaimTxClaimed(ctx, group.Txs[i].Data)
+		if err != nil || !claimed {
+			return false, err
+		}
+	}
+	return len(group.Txs) > 0, nil
 }
 
 func (tm *MonitorCompressedTxs) OnFinishClaimGroupTxFailed(group *ctmtypes.MonitoredTxGroup, txIndex int, msg string) {
@@ -245,6 +292,9 @@ func (tm *MonitorCompressedTxs) CheckReceipts(ctx context.Context, pendingTx *Pe
 						continue
 					}
 					log.Infof("tx_id:%d tx_hash:%s mined:%v receipt_status:%v len_logs:%d", txIndex, tx.TxHash.String(), mined, receipt.Status, len(receipt.Logs))
+					metrics.ClaimTxMined(tm.rollupID, receipt.GasUsed, receipt.EffectiveGasPrice)
+					// the reverted claim txs are paid too
+					group.DbEntry.Cost.AddReceipt(receipt)
 
 					if receipt.Status == types.ReceiptStatusSuccessful && len(receipt.Logs) > 0 {
 						tm.OnFinishClaimGroupTxSuccessful(group, txIndex)
@@ -310,19 +360,36 @@ func (tm *MonitorCompressedTxs) CanSendNewClaimCall(group *ctmtypes.MonitoredTxG
 	return elapsed >= tm.cfg.GroupingClaims.RetryInterval.Duration
 }
 
-func (tm *MonitorCompressedTxs) SendClaims(pendingTx *PendingTxs, onlyFirstOne bool) error {
+func (tm *MonitorCompressedTxs) SendClaims(ctx context.Context, pendingTx *PendingTxs, onlyFirstOne bool) error {
 	for _, group := range pendingTx.GroupTx {
 		if group.DbEntry.CompressedTxData == nil {
 			log.Warnf("group %d has no compressed data", group.DbEntry.GroupID)
 			continue
 		}
+		if !onlyFirstOne && tm.IsClaimCallReplaceable(group) {
+			tm.replaceClaimCall(ctx, group)
+			continue
+		}
 		if !tm.CanSendNewClaimCall(group) {
 			continue
 		}
 		if onlyFirstOne && !group.DbEntry.IsClaimTxHistoryEmpty() {
 			continue
 		}
+		claimed, err := tm.isGroupClaimedExternally(ctx, group)
+		if err != nil {
+			msg := fmt.Sprintf("failed to check if the deposits of group %d are claimed: %v", group.DbEntry.GroupID, err)
+			log.Warn(msg)
+			group.DbEntry.LastLog = msg
+			continue
+		}
+		if claimed {
+			tm.OnGroupClaimedExternally(group)
+			continue
+		}
 
+		_, span := tracing.StartSpan(ctx, "claimtxman.sendCompressedClaims", tracing.RollupID(tm.rollupID),
+			tracing.GroupID(group.DbEntry.GroupID), tracing.DepositIDs(getDepositIDs(group.Txs)))
 		// Estimating Gas
 		auth := *tm.auth
 		auth.NoSend = true
@@ -331,31 +398,140 @@ func (tm *MonitorCompressedTxs) SendClaims(pendingTx *PendingTxs, onlyFirstOne b
 			msg := fmt.Sprintf("failed to call SMC SendCompressedClaims for group %d: %v", group.DbEntry.GroupID, err)
 			log.Warn(msg)
 			group.DbEntry.LastLog = msg
+			tracing.EndSpan(span, err)
 			continue
 		}
 		auth.NoSend = false
 		log.Debug("estimatedGAS: ", estimatedTx.Gas())
 		auth.GasLimit = estimatedTx.Gas() + tm.gasOffset
 		log.Debug("New GAS: ", auth.GasLimit)
+		// The fees are set like the ones of the regular claims, the group is deferred while they are over the caps
+		groupTx := ctmtypes.MonitoredTx{Gas: auth.GasLimit}
+		err = tm.fees.setFees(ctx, &groupTx)
+		if errors.Is(err, errFeesOverCap) {
+			msg := fmt.Sprintf("claim tx of group %d deferred: %v", group.DbEntry.GroupID, err)
+			log.Warn(msg)
+			group.DbEntry.LastLog = msg
+			metrics.ClaimTxDeferred(tm.rollupID)
+			tracing.EndSpan(span, nil)
+			continue
+		} else if err != nil {
+			msg := fmt.Sprintf("failed to set the fees of the claim tx of group %d: %v", group.DbEntry.GroupID, err)
+			log.Warn(msg)
+			group.DbEntry.LastLog = msg
+			tracing.EndSpan(span, err)
+			continue
+		}
+		auth.GasPrice = groupTx.GasPrice
+		auth.GasTipCap = groupTx.GasTipCap
+		auth.GasFeeCap = groupTx.GasFeeCap
 		// Send claim tx
 		tx, err := tm.etherMan.SendCompressedClaims(&auth, group.DbEntry.CompressedTxData)
 		if err != nil {
 			msg := fmt.Sprintf("failed to call SMC SendCompressedClaims for group %d: %v", group.DbEntry.GroupID, err)
 			log.Warn(msg)
 			group.DbEntry.LastLog = msg
+			tracing.EndSpan(span, err)
 			continue
 		}
 		log.Debug("Gas used: ", tx.Gas())
 		log.Infof("Send claim tx try: %d for group_id:%d  deposits_id:%s txHash:%s", group.DbEntry.NumRetries, group.DbEntry.GroupID, group.GetTxsDepositIDString(), tx.Hash().String())
+		if group.DbEntry.NumRetries > 0 {
+			metrics.ClaimGroupRetry(tm.rollupID)
+		}
 		group.DbEntry.Status = ctmtypes.MonitoredTxGroupStatusClaiming
 		group.DbEntry.AddPendingTx(tx.Hash())
 		group.DbEntry.NumRetries++
+		span.SetAttributes(tracing.TxHash(tx.Hash()))
+		tracing.EndSpan(span, nil)
 	}
 	return nil
 }
 
-func (tm *MonitorCompressedTxs) createNewGroups(pendingTx *PendingTxs) error {
+// IsClaimCallReplaceable returns whether the more recent claim tx of the group has been pending long
+// enough to be replaced by a tx with bumped fees and the group can still replace it.
+func (tm *MonitorCompressedTxs) IsClaimCallReplaceable(group *ctmtypes.MonitoredTxGroup) bool {
+	cfg := tm.cfg.TxReplacement
+	if !cfg.Enabled || group.DbEntry.Status != ctmtypes.MonitoredTxGroupStatusClaiming || group.DbEntry.IsClaimTxHistoryEmpty() {
+		return false
+	}
+	moreRecentTx := group.DbEntry.ClaimTxHistory.GetMoreRecentTx()
+	if !moreRecentTx.IsPending() || uint64(group.DbEntry.ClaimTxHistory.NumReplacements()) >= cfg.MaxBumps {
+		return false
+	}
+	return tm.timeProvider.Now().Sub(moreRecentTx.CreatedAt) >= cfg.Timeout.Duration
+}
+
+// replaceClaimCall replaces the more recent claim tx of the group, still pending, by a tx with the
+// same nonce and bumped fees. The replaced tx is kept in the history as it can still be mined.
+func (tm *MonitorCompressedTxs) replaceClaimCall(ctx context.Context, group *ctmtypes.MonitoredTxGroup) {
+	replacedTxHash := group.DbEntry.ClaimTxHistory.GetMoreRecentTx().TxHash
+	ctx, span := tracing.StartSpan(ctx, "claimtxman.replaceCompressedClaims", tracing.RollupID(tm.rollupID),
+		tracing.GroupID(group.DbEntry.GroupID), tracing.DepositIDs(getDepositIDs(group.Txs)))
 	var err error
+	defer func() { tracing.EndSpan(span, err) }()
+
+	pendingTx, isPending, err := tm.l2Node.TransactionByHash(ctx, replacedTxHash)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get the pending claim tx %s of group %d: %v", replacedTxHash.String(), group.DbEntry.GroupID, err)
+		log.Warn(msg)
+		group.DbEntry.LastLog = msg
+		return
+	}
+	if !isPending {
+		// The receipt is checked later
+		return
+	}
+	// The pending tx is not replaced when all the deposits were claimed, it could be the claim tx
+	// mined meanwhile. The receipt is checked later
+	claimed, err := tm.isGroupClaimedExternally(ctx, group)
+	if err != nil {
+		msg := fmt.Sprintf("failed to check if the deposits of group %d are claimed: %v", group.DbEntry.GroupID, err)
+		log.Warn(msg)
+		group.DbEntry.LastLog = msg
+		return
+	}
+	if claimed {
+		log.Infof("group_id:%d , all the deposits are claimed, the claim tx %s is not replaced", group.DbEntry.GroupID, replacedTxHash.String())
+		return
+	}
+	bumpedTx := ctmtypes.MonitoredTx{Gas: pendingTx.Gas()}
+	err = tm.fees.setReplacementFees(ctx, &bumpedTx, pendingTx.GasTipCap(), pendingTx.GasFeeCap(), tm.cfg.TxReplacement.FeeBumpPercentage)
+	if errors.Is(err, errFeesOverCap) {
+		msg := fmt.Sprintf("claim tx %s of group %d not replaced: %v", replacedTxHash.String(), group.DbEntry.GroupID, err)
+		log.Warn(msg)
+		group.DbEntry.LastLog = msg
+		metrics.ClaimTxDeferred(tm.rollupID)
+		err = nil
+		return
+	} else if err != nil {
+		msg := fmt.Sprintf("failed to set the fees to replace the claim tx %s of group %d: %v", replacedTxHash.String(), group.DbEntry.GroupID, err)
+		log.Warn(msg)
+		group.DbEntry.LastLog = msg
+		return
+	}
+	auth := *tm.auth
+	auth.Nonce = new(big.Int).SetUint64(pendingTx.Nonce())
+	auth.GasLimit = pendingTx.Gas()
+	auth.GasPrice = bumpedTx.GasPrice
+	auth.GasTipCap = bumpedTx.GasTipCap
+	auth.GasFeeCap = bumpedTx.GasFeeCap
+	tx, err := tm.etherMan.SendCompressedClaims(&auth, group.DbEntry.CompressedTxData)
+	if err != nil {
+		msg := fmt.Sprintf("failed to replace the claim tx %s of group %d: %v", replacedTxHash.String(), group.DbEntry.GroupID, err)
+		log.Warn(msg)
+		group.DbEntry.LastLog = msg
+		return
+	}
+	msg := fmt.Sprintf("group_id:%d , claim tx %s replaced by %s with bumped fees", group.DbEntry.GroupID, replacedTxHash.String(), tx.Hash().String())
+	log.Info(msg)
+	group.DbEntry.LastLog = msg
+	group.DbEntry.ClaimTxHistory.AddReplacementTx(tx.Hash(), replacedTxHash)
+	metrics.ClaimTxReplaced(tm.rollupID)
+	span.SetAttributes(tracing.TxHash(tx.Hash()))
+}
+
+func (tm *MonitorCompressedTxs) createNewGroups(ctx context.Context, pendingTx *PendingTxs) (err error) {
 	if pendingTx == nil || pendingTx.TxCandidatesForGroup == nil {
 		return nil
 	}
@@ -364,6 +540,14 @@ func (tm *MonitorCompressedTxs) createNewGroups(pendingTx *PendingTxs) error {
 		log.Infof("pending claims: %d, not yet trigged", len(pendingTx.TxCandidatesForGroup))
 		return nil
 	}
+	groupTxs, err = tm.removeClaimedExternally(ctx, pendingTx, groupTxs)
+	if err != nil {
+		return err
+	}
+	if len(groupTxs) == 0 {
+		log.Infof("all the claims chosen to be grouped were claimed externally")
+		return nil
+	}
 	group := ctmtypes.NewMonitoredTxGroup(
 		ctmtypes.MonitoredTxGroupDBEntry{
 			GroupID: pendingTx.GenerateNewGroupID(),
@@ -372,6 +556,9 @@ func (tm *MonitorCompressedTxs) createNewGroups(pendingTx *PendingTxs) error {
 			//From:    pendingTx.TxCandidatesForGroup[0].From,
 			CreatedAt: tm.timeProvider.Now(),
 		}, groupTxs)
+	_, span := tracing.StartSpan(ctx, "claimtxman.createClaimGroup", tracing.RollupID(tm.rollupID),
+		tracing.GroupID(group.DbEntry.GroupID), tracing.DepositIDs(getDepositIDs(group.Txs)))
+	defer func() { tracing.EndSpan(span, err) }()
 
 	// Group createdTx and generate compress calls
 	compressClaimParams, err := tm.compressClaimComposer.GetCompressClaimParametersFromMonitoredTx(group.Txs)
@@ -387,5 +574,26 @@ func (tm *MonitorCompressedTxs) createNewGroups(pendingTx *PendingTxs) error {
 	group.DbEntry.CompressedTxData = compressedData
 
 	pendingTx.AddGroup(group)
+	metrics.ClaimGroupCreated(tm.rollupID, len(group.Txs))
 	return nil
 }
+
+// removeClaimedExternally removes the txs whose deposits are already claimed on-chain by other txs
+// before grouping them, they are moved to the claimed externally status.
+func (tm *MonitorCompressedTxs) removeClaimedExternally(ctx context.Context, pendingTx *PendingTxs, txs []ctmtypes.MonitoredTx) ([]ctmtypes.MonitoredTx, error) {
+	result := make([]ctmtypes.MonitoredTx, 0, len(txs))
+	for _, tx := range txs {
+		claimed, err := tm.claimed.IsClaimTxClaimed(ctx, tx.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if deposit_id %d is claimed: %w", tx.DepositID, err)
+		}
+		if claimed {
+			log.Infof("deposit_id:%d already claimed on-chain by another tx, it is not grouped", tx.DepositID)
+			pendingTx.setClaimedExternally(tx)
+			metrics.ClaimedExternally(tm.rollupID)
+			continue
+		}
+		result = append(result, tx)
+	}
+	return result, nil
+}
@@ -1,10 +1,15 @@
 package claimtxman_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/go-ethereum/common"
 	"github.com/fiwallets/zkevm-bridge-service/claimtxman"
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/barkimedes/go-deepcopy"
 	"github.com/stretchr/testify/require"
 )
@@ -49,3 +54,48 @@ func TestDeepCopy2(t *testing.T) {
 	copied := *initialStatus.(*claimtxman.PendingTxs)
 	require.Equal(t, pendingTx, copied)
 }
+
+func TestIsClaimCallReplaceable(t *testing.T) {
+	now := time.Now()
+	cfg := claimtxman.Config{
+		TxReplacement: claimtxman.ConfigTxReplacement{
+			Enabled:           true,
+			Timeout:           types.NewDuration(time.Minute),
+			FeeBumpPercentage: 10,
+			MaxBumps:          2,
+		},
+	}
+	tm := claimtxman.NewMonitorCompressedTxs(context.Background(), nil, nil, cfg, nil, nil, utils.TimeProviderFixedTime{FixedTime: now}, 0, 1, nil, nil, nil)
+	group := &ctmtypes.MonitoredTxGroup{
+		DbEntry: ctmtypes.MonitoredTxGroupDBEntry{
+			Status: ctmtypes.MonitoredTxGroupStatusClaiming,
+			ClaimTxHistory: &ctmtypes.TxHistoryV2{
+				TxHashes: []ctmtypes.TxHashHistoryEntry{{TxHash: common.HexToHash("0x01"), CreatedAt: now.Add(-2 * time.Minute)}},
+			},
+		},
+	}
+	require.True(t, tm.IsClaimCallReplaceable(group))
+
+	// The more recent tx has not been pending long enough
+	group.DbEntry.ClaimTxHistory.AddReplacementTx(common.HexToHash("0x02"), common.HexToHash("0x01"))
+	group.DbEntry.ClaimTxHistory.TxHashes[1].CreatedAt = now.Add(-30 * time.Second)
+	require.False(t, tm.IsClaimCallReplaceable(group))
+	group.DbEntry.ClaimTxHistory.TxHashes[1].CreatedAt = now.Add(-time.Minute)
+	require.True(t, tm.IsClaimCallReplaceable(group))
+
+	// The more recent tx is not pending
+	group.DbEntry.ClaimTxHistory.TxHashes[1].ReceiptFailed()
+	require.False(t, tm.IsClaimCallReplaceable(group))
+
+	// The group reached the maximum bumps
+	group.DbEntry.ClaimTxHistory.TxHashes[1].CreatedAt = now.Add(-3 * time.Minute)
+	group.DbEntry.ClaimTxHistory.AddReplacementTx(common.HexToHash("0x03"), common.HexToHash("0x02"))
+	group.DbEntry.ClaimTxHistory.TxHashes[2].CreatedAt = now.Add(-time.Minute)
+	require.False(t, tm.IsClaimCallReplaceable(group))
+
+	// The group is not claiming
+	group.DbEntry.ClaimTxHistory.TxHashes = group.DbEntry.ClaimTxHistory.TxHashes[:1]
+	require.True(t, tm.IsClaimCallReplaceable(group))
+	group.DbEntry.Status = ctmtypes.MonitoredTxGroupStatusCreated
+	require.False(t, tm.IsClaimCallReplaceable(group))
+}
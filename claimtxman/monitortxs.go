@@ -10,40 +10,53 @@ import (
 
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
 	"github.com/fiwallets/go-ethereum"
-	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/fiwallets/go-ethereum/core/types"
+	"github.com/fiwallets/go-ethereum/params"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type MonitorTxs struct {
 	storage StorageInterface
 	ctx     context.Context
 	// client is the ethereum client
-	l2Node     *utils.Client
-	cfg        Config
-	rollupID   uint32
-	nonceCache *NonceCache
-	auth       *bind.TransactOpts
+	l2Node   *utils.Client
+	cfg      Config
+	rollupID uint32
+	nonces   *NonceAllocator
+	signers  *SignerPool
+	fees     *txFees
+	claimed  *ClaimedChecker
+	priority *ClaimPrioritizer
 }
 
 func NewMonitorTxs(ctx context.Context,
 	storage StorageInterface,
 	l2Node *utils.Client,
 	cfg Config,
-	nonceCache *NonceCache,
+	nonces *NonceAllocator,
 	rollupID uint32,
-	auth *bind.TransactOpts) *MonitorTxs {
+	signers *SignerPool,
+	fees *txFees,
+	claimed *ClaimedChecker,
+	priority *ClaimPrioritizer) *MonitorTxs {
 	return &MonitorTxs{
-		rollupID:   rollupID,
-		storage:    storage,
-		ctx:        ctx,
-		l2Node:     l2Node,
-		cfg:        cfg,
-		nonceCache: nonceCache,
-		auth:       auth,
+		rollupID: rollupID,
+		storage:  storage,
+		ctx:      ctx,
+		l2Node:   l2Node,
+		cfg:      cfg,
+		nonces:   nonces,
+		signers:  signers,
+		fees:     fees,
+		claimed:  claimed,
+		priority: priority,
 	}
 }
 
@@ -66,146 +79,295 @@ func (tm *MonitorTxs) MonitorTxs(ctx context.Context) error {
 		return fmt.Errorf("rollupID: %d, failed to get created monitored txs: %v", tm.rollupID, err)
 	}
 
-	isResetNonce := false // it will reset the nonce in one cycle
 	log.Infof("rollupID: %d, found %v monitored tx to process", tm.rollupID, len(mTxs))
+	// the txs with the highest priority are sent first
+	tm.priority.Sort(time.Now(), mTxs)
 	for _, mTx := range mTxs {
 		mTx := mTx // force variable shadowing to avoid pointer conflicts
-		mTxLog := log.WithFields("monitoredTx", mTx.DepositID, "rollupID", tm.rollupID)
+		ctx, span := tracing.StartSpan(ctx, "claimtxman.monitorTx", tracing.RollupID(tm.rollupID),
+			tracing.DepositID(mTx.DepositID), tracing.GlobalExitRoot(mTx.GlobalExitRoot))
+		err := tm.monitorTx(ctx, &mTx, dbTx)
+		span.SetAttributes(tracing.Status(mTx.Status.String()))
+		tracing.EndSpan(span, err)
+	}
+
+	for _, signer := range tm.signers.Addresses() {
+		tm.fillNonceGaps(ctx, signer, dbTx)
+	}
+
+	err = tm.storage.Commit(tm.ctx, dbTx)
+	if err != nil {
+		log.Errorf("rollupID: %d, UpdateClaimTx committing dbTx, err: %v", tm.rollupID, err)
+		rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("rollupID: %d, claimtxman error rolling back state. RollbackErr: %s, err: %v", tm.rollupID, rollbackErr.Error(), err)
+			return rollbackErr
+		}
+		return err
+	}
+	return nil
+}
+
+// monitorTx processes a pending monitored tx: it confirms it if any tx of its history was
+// mined successfully, otherwise it sends a new tx when all the previous ones were mined or
+// dropped. The errors are logged here and only returned to be recorded in the span.
+func (tm *MonitorTxs) monitorTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	mTxLog := log.WithFields("monitoredTx", mTx.DepositID, "rollupID", tm.rollupID)
+	if mTx.NonceReserved {
 		mTxLog.Infof("processing tx with nonce %d", mTx.Nonce)
+	} else {
+		mTxLog.Infof("processing tx without nonce")
+	}
 
-		// if the tx is not mined yet, check that not all the tx were mined and go to the next
-		// check if the tx is in the pending pool
-		// Retry if the tx has not appeared in the pool yet.
-		// if the tx was mined successfully we can break the loop and proceed
-		// update monitored tx changes into storage
-		// if the tx was mined but failed, we continue to consider it was not mined
-		// and store the failed receipt to be used to check if nonce needs to be reviewed
-		hasFailedReceipts, allHistoryTxMined, receiptSuccessful := tm.checkTxHistory(ctx, mTx, mTxLog)
+	// if the tx is not mined yet, check that not all the tx were mined and go to the next
+	// check if the tx is in the pending pool
+	// Retry if the tx has not appeared in the pool yet.
+	// if the tx was mined successfully we can break the loop and proceed
+	// update monitored tx changes into storage
+	// if the tx was mined but failed, we continue to consider it was not mined
+	// and store the failed receipt to be used to check if nonce needs to be reviewed
+	hasFailedReceipts, allHistoryTxMined, receiptSuccessful := tm.checkTxHistory(ctx, *mTx, mTxLog)
 
-		if receiptSuccessful {
-			//mTxLog.Infof("tx %s was mined successfully", txHash.String())
+	if receiptSuccessful {
+		//mTxLog.Infof("tx %s was mined successfully", txHash.String())
 
-			mTx.Status = ctmtypes.MonitoredTxStatusConfirmed
+		mTx.Status = ctmtypes.MonitoredTxStatusConfirmed
+		tm.setCost(ctx, mTx, mTxLog)
 
-			err = tm.storage.UpdateClaimTx(ctx, mTx, dbTx)
-			if err != nil {
-				mTxLog.Errorf("failed to update monitored tx when confirmed: %v", err)
-			}
-			continue
+		err := tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+		if err != nil {
+			mTxLog.Errorf("failed to update monitored tx when confirmed: %v", err)
 		}
+		return err
+	}
 
-		// if the history size reaches the max history size, this means something is really wrong with
-		// this Tx and we are not able to identify automatically, so we mark this as failed to let the
-		// caller know something is not right and needs to be review and to avoid to monitor this
-		// tx infinitely
-		if allHistoryTxMined && len(mTx.History) >= maxHistorySize {
-			mTx.Status = ctmtypes.MonitoredTxStatusFailed
-			mTxLog.Infof("marked as failed because reached the history size limit (%d)", maxHistorySize)
-			// update monitored tx changes into storage
-			err = tm.storage.UpdateClaimTx(ctx, mTx, dbTx)
+	// if the history size reaches the max history size, this means something is really wrong with
+	// this Tx and we are not able to identify automatically, so we mark this as failed to let the
+	// caller know something is not right and needs to be review and to avoid to monitor this
+	// tx infinitely
+	if allHistoryTxMined && len(mTx.History) >= maxHistorySize {
+		mTx.Status = ctmtypes.MonitoredTxStatusFailed
+		mTxLog.Infof("marked as failed because reached the history size limit (%d)", maxHistorySize)
+		tm.setCost(ctx, mTx, mTxLog)
+		// update monitored tx changes into storage
+		err := tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+		if err != nil {
+			mTxLog.Errorf("failed to update monitored tx when max history size limit reached: %v", err)
+		}
+		return err
+	}
+
+	// if we have failed receipts, this means at least one of the generated txs was mined
+	// so maybe the current nonce was already consumed, then we need to check if there are
+	// tx that were not mined yet, if so, we just need to wait, because maybe one of them
+	// will get mined successfully
+	if allHistoryTxMined {
+		// the deposit can be claimed by another tx meanwhile, then it is not sent
+		if claimed, err := tm.checkClaimedExternally(ctx, mTx, dbTx, mTxLog); err != nil || claimed {
+			return err
+		}
+		// in case of all tx were mined and none of them were mined successfully, we need to
+		// review the tx information
+		if hasFailedReceipts {
+			mTxLog.Infof("monitored tx needs to be updated")
+			err := tm.ReviewMonitoredTx(ctx, mTx, true, dbTx)
 			if err != nil {
-				mTxLog.Errorf("failed to update monitored tx when max history size limit reached: %v", err)
+				mTxLog.Errorf("failed to review monitored tx: %v", err)
+				return err
 			}
+		}
+
+		// The fees are set here to use always the proper and most accurate values right before sending it to L2
+		err := tm.fees.setFees(ctx, mTx)
+		if errors.Is(err, errFeesOverCap) {
+			// The claim stays created to be sent once the fees are lower
+			mTxLog.Warnf("claim tx deferred: %v", err)
+			metrics.ClaimTxDeferred(tm.rollupID)
+			return nil
+		} else if err != nil {
+			mTxLog.Errorf("failed to set the tx fees. Error: %v", err)
+			return err
+		}
+		if mTx.GasFeeCap != nil {
+			mTxLog.Infof("Using gasTipCap: %s, gasFeeCap: %s", mTx.GasTipCap.String(), mTx.GasFeeCap.String())
+		} else {
+			mTxLog.Infof("Using gasPrice: %s", mTx.GasPrice.String())
+		}
+
+		if err := tm.reserveNonce(ctx, mTx, dbTx, mTxLog); err != nil {
+			return err
+		}
+		return tm.sendTx(ctx, mTx, dbTx, mTxLog)
+	}
+
+	// if the tx has been pending for too long, it is replaced by a tx with the same nonce and
+	// bumped fees
+	if tm.isReplaceable(*mTx, time.Now()) {
+		// the pending tx is not replaced when the deposit was claimed by another tx. The monitored
+		// tx is moved to claimed externally once the pending tx is mined or dropped, as it could
+		// be the claim tx mined meanwhile
+		claimed, err := tm.claimed.IsClaimTxClaimed(ctx, mTx.Data)
+		if err != nil {
+			mTxLog.Errorf("failed to check if the deposit is claimed: %v", err)
+			return err
+		}
+		if claimed {
+			mTxLog.Infof("deposit already claimed on-chain, the pending tx is not replaced")
+			return nil
+		}
+		mTxLog.Infof("tx pending since %s, replacing it with bumped fees. Bump %d of %d", mTx.SentAt.String(), mTx.Bumps+1, tm.cfg.TxReplacement.MaxBumps)
+		pendingTipCap, pendingFeeCap := mTx.GasTipCap, mTx.GasFeeCap
+		if mTx.GasFeeCap == nil {
+			pendingTipCap, pendingFeeCap = mTx.GasPrice, mTx.GasPrice
+		}
+		err = tm.fees.setReplacementFees(ctx, mTx, pendingTipCap, pendingFeeCap, tm.cfg.TxReplacement.FeeBumpPercentage)
+		if errors.Is(err, errFeesOverCap) {
+			// The pending tx is kept
+			mTxLog.Warnf("claim tx not replaced: %v", err)
+			metrics.ClaimTxDeferred(tm.rollupID)
+			return nil
+		} else if err != nil {
+			mTxLog.Errorf("failed to set the replacement tx fees. Error: %v", err)
+			return err
+		}
+		mTx.Bumps++
+		metrics.ClaimTxReplaced(tm.rollupID)
+		return tm.sendTx(ctx, mTx, dbTx, mTxLog)
+	}
+	return nil
+}
+
+// reserveNonce reserves the nonce of a monitored tx sent for the first time. The txs are sent by
+// priority, so the higher priority ones get the lower nonces and are mined first.
+func (tm *MonitorTxs) reserveNonce(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx, mTxLog *log.Logger) error {
+	if mTx.NonceReserved {
+		return nil
+	}
+	nonce, err := tm.nonces.ReserveNonce(ctx, mTx.From, dbTx)
+	if err != nil {
+		mTxLog.Errorf("failed to reserve nonce: %v", err)
+		return err
+	}
+	mTxLog.Infof("nonce %d reserved", nonce)
+	mTx.Nonce = nonce
+	mTx.NonceReserved = true
+	return nil
+}
+
+// checkClaimedExternally checks on-chain whether the deposit of the monitored tx was claimed by
+// another tx, in that case the monitored tx is stored as claimed externally instead of being sent.
+func (tm *MonitorTxs) checkClaimedExternally(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx, mTxLog *log.Logger) (bool, error) {
+	claimed, err := tm.claimed.IsClaimTxClaimed(ctx, mTx.Data)
+	if err != nil {
+		mTxLog.Errorf("failed to check if the deposit is claimed: %v", err)
+		return false, err
+	}
+	if !claimed {
+		return false, nil
+	}
+	mTxLog.Infof("deposit already claimed on-chain by another tx, the claim tx is not sent")
+	mTx.Status = ctmtypes.MonitoredTxStatusClaimedExternally
+	metrics.ClaimedExternally(tm.rollupID)
+	tm.setCost(ctx, mTx, mTxLog)
+	err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+	if err != nil {
+		mTxLog.Errorf("failed to update monitored tx when claimed externally: %v", err)
+	}
+	return true, err
+}
+
+// setCost sets the cost of the mined txs of the history when the monitoring finishes, the reverted
+// txs were paid too. The txs whose receipts can't be got are not included.
+func (tm *MonitorTxs) setCost(ctx context.Context, mTx *ctmtypes.MonitoredTx, mTxLog *log.Logger) {
+	var cost ctmtypes.TxCost
+	for txHash := range mTx.History {
+		mined, receipt, err := tm.l2Node.CheckTxWasMined(ctx, txHash)
+		if err != nil {
+			mTxLog.Errorf("failed to get the receipt of tx %s to set the cost: %v", txHash.String(), err)
 			continue
 		}
+		if mined {
+			cost.AddReceipt(receipt)
+		}
+	}
+	mTx.Cost = cost
+	if !cost.IsEmpty() {
+		mTxLog.Infof("claim cost: gas used %d, effective gas price %s, fee %s", cost.GasUsed, cost.EffectiveGasPrice.String(), cost.Fee.String())
+	}
+}
 
-		// if we have failed receipts, this means at least one of the generated txs was mined
-		// so maybe the current nonce was already consumed, then we need to check if there are
-		// tx that were not mined yet, if so, we just need to wait, because maybe one of them
-		// will get mined successfully
-		if allHistoryTxMined {
-			// in case of all tx were mined and none of them were mined successfully, we need to
-			// review the tx information
-			if hasFailedReceipts {
-				mTxLog.Infof("monitored tx needs to be updated")
-				err := tm.ReviewMonitoredTx(ctx, &mTx, true)
-				if err != nil {
-					mTxLog.Errorf("failed to review monitored tx: %v", err)
-					continue
-				}
-			}
+// isReplaceable returns whether the pending tx has been waiting long enough to be replaced by a tx
+// with bumped fees and it can still be replaced.
+func (tm *MonitorTxs) isReplaceable(mTx ctmtypes.MonitoredTx, now time.Time) bool {
+	cfg := tm.cfg.TxReplacement
+	return cfg.Enabled && mTx.SentAt != nil && mTx.Bumps < cfg.MaxBumps && now.Sub(*mTx.SentAt) >= cfg.Timeout.Duration
+}
 
-			// GasPrice is set here to use always the proper and most accurate value right before sending it to L2
-			gasPrice, err := tm.l2Node.SuggestGasPrice(ctx)
-			if err != nil {
-				mTxLog.Errorf("failed to get suggested gasPrice. Error: %v", err)
-				continue
-			}
-			//Multiply gasPrice by 10 to increase the efficiency of the tx in the sequence
-			mTx.GasPrice = big.NewInt(0).Mul(gasPrice, big.NewInt(10)) //nolint:gomnd
-			mTxLog.Infof("Using gasPrice: %s. The gasPrice suggested by the network is %s", mTx.GasPrice.String(), gasPrice.String())
+// sendTx signs the monitored tx with its current information and sends it to the network, adding it
+// to the history. The monitored tx changes are stored.
+func (tm *MonitorTxs) sendTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx, mTxLog *log.Logger) error {
+	// rebuild transaction
+	tx := mTx.Tx()
+	mTxLog.Debugf("unsigned tx created for monitored tx")
 
-			// rebuild transaction
-			tx := mTx.Tx()
-			mTxLog.Debugf("unsigned tx created for monitored tx")
+	// sign tx with the signer assigned to the monitored tx
+	auth, err := tm.signers.Signer(mTx.From)
+	if err != nil {
+		mTxLog.Errorf("failed to get the signer of the monitored tx: %v", err)
+		return err
+	}
+	signedTx, err := auth.Signer(mTx.From, tx)
+	if err != nil {
+		mTxLog.Errorf("failed to sign tx %v created from monitored tx: %v", tx.Hash().String(), err)
+		return err
+	}
+	mTxLog.Debugf("signed tx %v of type %d created using gasTipCap: %s, gasFeeCap: %s", signedTx.Hash().String(), signedTx.Type(), signedTx.GasTipCap().String(), signedTx.GasFeeCap().String())
 
-			var signedTx *types.Transaction
-			// sign tx
-			signedTx, err = tm.auth.Signer(mTx.From, tx)
-			if err != nil {
-				mTxLog.Errorf("failed to sign tx %v created from monitored tx: %v", tx.Hash().String(), err)
-				continue
-			}
-			mTxLog.Debugf("signed tx %v created using gasPrice: %s", signedTx.Hash().String(), signedTx.GasPrice().String())
-
-			// add tx to monitored tx history
-			err = mTx.AddHistory(signedTx)
-			if errors.Is(err, ctmtypes.ErrAlreadyExists) {
-				mTxLog.Infof("signed tx already existed in the history")
-			} else if err != nil {
-				mTxLog.Errorf("failed to add signed tx to monitored tx history: %v", err)
-				continue
-			}
+	// add tx to monitored tx history
+	err = mTx.AddHistory(signedTx)
+	if errors.Is(err, ctmtypes.ErrAlreadyExists) {
+		mTxLog.Infof("signed tx already existed in the history")
+	} else if err != nil {
+		mTxLog.Errorf("failed to add signed tx to monitored tx history: %v", err)
+		return err
+	}
 
-			// check if the tx is already in the network, if not, send it
-			_, _, err = tm.l2Node.TransactionByHash(ctx, signedTx.Hash())
-			if errors.Is(err, ethereum.NotFound) {
-				err := tm.l2Node.SendTransaction(ctx, signedTx)
-				if err != nil {
-					mTxLog.Errorf("failed to send tx %s to network: %v", signedTx.Hash().String(), err)
-					var reviewNonce bool
-					if strings.Contains(err.Error(), "nonce") {
-						mTxLog.Infof("nonce error detected, Nonce used: %d", signedTx.Nonce())
-						if !isResetNonce {
-							isResetNonce = true
-							tm.nonceCache.Remove(mTx.From.Hex())
-							mTxLog.Infof("nonce cache cleared for address %v", mTx.From.Hex())
-						}
-						reviewNonce = true
-					}
-					mTx.RemoveHistory(signedTx)
-					// we should rebuild the monitored tx to fix the nonce
-					err := tm.ReviewMonitoredTx(ctx, &mTx, reviewNonce)
-					if err != nil {
-						mTxLog.Errorf("failed to review monitored tx: %v", err)
-					}
-				}
-			} else if err != nil && !errors.Is(err, ethereum.NotFound) {
-				mTxLog.Error("unexpected error getting TransactionByHash. Error: ", err)
-			} else {
-				mTxLog.Infof("signed tx %v already found in the network for the monitored tx.", signedTx.Hash().String())
+	trace.SpanFromContext(ctx).SetAttributes(tracing.TxHash(signedTx.Hash()))
+	// check if the tx is already in the network, if not, send it
+	_, _, err = tm.l2Node.TransactionByHash(ctx, signedTx.Hash())
+	if errors.Is(err, ethereum.NotFound) {
+		err := tm.l2Node.SendTransaction(ctx, signedTx)
+		if err == nil {
+			sentAt := time.Now().UTC()
+			mTx.SentAt = &sentAt
+		} else {
+			mTxLog.Errorf("failed to send tx %s to network: %v", signedTx.Hash().String(), err)
+			trace.SpanFromContext(ctx).RecordError(err)
+			var reviewNonce bool
+			if strings.Contains(err.Error(), "nonce") {
+				mTxLog.Infof("nonce error detected, Nonce used: %d", signedTx.Nonce())
+				reviewNonce = true
 			}
-
-			// update monitored tx changes into storage
-			err = tm.storage.UpdateClaimTx(ctx, mTx, dbTx)
+			mTx.RemoveHistory(signedTx)
+			// we should rebuild the monitored tx to fix the nonce
+			err := tm.ReviewMonitoredTx(ctx, mTx, reviewNonce, dbTx)
 			if err != nil {
-				mTxLog.Errorf("failed to update monitored tx: %v", err)
-				continue
+				mTxLog.Errorf("failed to review monitored tx: %v", err)
 			}
-			mTxLog.Infof("signed tx %s added to the monitored tx history", signedTx.Hash().String())
 		}
+	} else if err != nil && !errors.Is(err, ethereum.NotFound) {
+		mTxLog.Error("unexpected error getting TransactionByHash. Error: ", err)
+	} else {
+		mTxLog.Infof("signed tx %v already found in the network for the monitored tx.", signedTx.Hash().String())
 	}
 
-	err = tm.storage.Commit(tm.ctx, dbTx)
+	// update monitored tx changes into storage
+	err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
 	if err != nil {
-		log.Errorf("rollupID: %d, UpdateClaimTx committing dbTx, err: %v", tm.rollupID, err)
-		rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Errorf("rollupID: %d, claimtxman error rolling back state. RollbackErr: %s, err: %v", tm.rollupID, rollbackErr.Error(), err)
-			return rollbackErr
-		}
+		mTxLog.Errorf("failed to update monitored tx: %v", err)
 		return err
 	}
+	mTxLog.Infof("signed tx %s added to the monitored tx history", signedTx.Hash().String())
 	return nil
 }
 
@@ -217,6 +379,8 @@ func (tm *MonitorTxs) checkTxHistory(ctx context.Context, mTx ctmtypes.Monitored
 	receiptSuccessful := false
 	mined := false
 	var err error
+	// missingTxs are the txs not found in the pool with the error returned
+	missingTxs := make(map[common.Hash]error)
 	for txHash := range mTx.History {
 		mTxLog.Infof("Checking if tx %s is mined", txHash.String())
 		mined, receipt, err = tm.l2Node.CheckTxWasMined(ctx, txHash)
@@ -229,20 +393,8 @@ func (tm *MonitorTxs) checkTxHistory(ctx context.Context, mTx ctmtypes.Monitored
 			_, _, err = tm.l2Node.TransactionByHash(ctx, txHash)
 			if err != nil {
 				mTxLog.Errorf("error getting txByHash %s. Error: %v", txHash.String(), err)
-
-				for i := 0; i < tm.cfg.RetryNumber && err != nil; i++ {
-					mTxLog.Warn("waiting and retrying to find the tx in the pool. TxHash: %s. Error: %v", txHash.String(), err)
-					time.Sleep(tm.cfg.RetryInterval.Duration)
-					_, _, err = tm.l2Node.TransactionByHash(ctx, txHash)
-				}
-				if errors.Is(err, ethereum.NotFound) {
-					mTxLog.Error("maximum retries and the tx is still missing in the pool. TxHash: ", txHash.String())
-					hasFailedReceipts = true
-					continue
-				} else if err != nil {
-					mTxLog.Errorf("failed to retry to get tx %s: %v", txHash.String(), err)
-					continue
-				}
+				missingTxs[txHash] = err
+				continue
 			}
 			mTxLog.Infof("tx: %s not mined yet", txHash.String())
 
@@ -253,18 +405,42 @@ func (tm *MonitorTxs) checkTxHistory(ctx context.Context, mTx ctmtypes.Monitored
 		if receipt.Status == types.ReceiptStatusSuccessful {
 			mTxLog.Infof("tx %s was mined successfully", txHash.String())
 			receiptSuccessful = true
+			metrics.ClaimTxMined(tm.rollupID, receipt.GasUsed, receipt.EffectiveGasPrice)
 
 			break
 		}
 		hasFailedReceipts = true
 	}
+	if receiptSuccessful || !allHistoryTxMined {
+		// The txs replaced by the pending one are not in the pool anymore
+		return hasFailedReceipts, allHistoryTxMined, receiptSuccessful
+	}
+
+	// Retry if the missing txs have not appeared in the pool yet
+	for txHash, err := range missingTxs {
+		for i := 0; i < tm.cfg.RetryNumber && err != nil; i++ {
+			mTxLog.Warn("waiting and retrying to find the tx in the pool. TxHash: %s. Error: %v", txHash.String(), err)
+			time.Sleep(tm.cfg.RetryInterval.Duration)
+			_, _, err = tm.l2Node.TransactionByHash(ctx, txHash)
+		}
+		if errors.Is(err, ethereum.NotFound) {
+			mTxLog.Error("maximum retries and the tx is still missing in the pool. TxHash: ", txHash.String())
+			hasFailedReceipts = true
+			continue
+		} else if err != nil {
+			mTxLog.Errorf("failed to retry to get tx %s: %v", txHash.String(), err)
+			continue
+		}
+		mTxLog.Infof("tx: %s not mined yet", txHash.String())
+		allHistoryTxMined = false
+	}
 	return hasFailedReceipts, allHistoryTxMined, receiptSuccessful
 }
 
 // ReviewMonitoredTx checks if tx needs to be updated
 // accordingly to the current information stored and the current
 // state of the blockchain
-func (tm *MonitorTxs) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, reviewNonce bool) error {
+func (tm *MonitorTxs) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, reviewNonce bool, dbTx pgx.Tx) error {
 	mTxLog := log.WithFields("monitoredTx", mTx.DepositID, "rollupID", tm.rollupID)
 	mTxLog.Debug("reviewing")
 	// get gas
@@ -293,14 +469,20 @@ func (tm *MonitorTxs) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.Monit
 	}
 
 	if reviewNonce {
-		// check nonce
-		nonce, err := tm.nonceCache.GetNextNonce(mTx.From)
+		// check nonce. A new nonce is reserved only when the current one was consumed by another
+		// tx, otherwise it is kept to not leave a gap
+		consumed, err := tm.nonces.IsNonceConsumed(ctx, mTx.From, mTx.Nonce)
 		if err != nil {
-			err := fmt.Errorf("failed to get nonce: %v", err)
 			mTxLog.Errorf(err.Error())
 			return err
 		}
-		if nonce > mTx.Nonce {
+		if consumed {
+			nonce, err := tm.nonces.ReserveNonce(ctx, mTx.From, dbTx)
+			if err != nil {
+				err := fmt.Errorf("failed to reserve nonce: %v", err)
+				mTxLog.Errorf(err.Error())
+				return err
+			}
 			mTxLog.Infof("monitored tx nonce updated from %v to %v", mTx.Nonce, nonce)
 			mTx.Nonce = nonce
 		}
@@ -308,3 +490,48 @@ func (tm *MonitorTxs) ReviewMonitoredTx(ctx context.Context, mTx *ctmtypes.Monit
 
 	return nil
 }
+
+// fillNonceGaps sends no-op txs with the nonces of the signer reserved for abandoned claims,
+// otherwise the claim txs with higher nonces are never mined.
+func (tm *MonitorTxs) fillNonceGaps(ctx context.Context, signer common.Address, dbTx pgx.Tx) {
+	gaps, err := tm.nonces.NonceGaps(ctx, signer, dbTx)
+	if err != nil {
+		log.Errorf("rollupID: %d, failed to get the nonce gaps of %s: %v", tm.rollupID, signer.String(), err)
+		return
+	}
+	for _, nonce := range gaps {
+		if err := tm.fillNonceGap(ctx, signer, nonce); err != nil {
+			log.Errorf("rollupID: %d, failed to fill the nonce gap %d of %s: %v", tm.rollupID, nonce, signer.String(), err)
+		}
+	}
+}
+
+// fillNonceGap sends a transfer of 0 to the signer itself with the nonce.
+func (tm *MonitorTxs) fillNonceGap(ctx context.Context, signer common.Address, nonce uint64) error {
+	noop := ctmtypes.MonitoredTx{From: signer, To: &signer, Nonce: nonce, Value: big.NewInt(0), Gas: params.TxGas}
+	err := tm.fees.setFees(ctx, &noop)
+	if err != nil {
+		return err
+	}
+	auth, err := tm.signers.Signer(signer)
+	if err != nil {
+		return err
+	}
+	signedTx, err := auth.Signer(signer, noop.Tx())
+	if err != nil {
+		return err
+	}
+	_, _, err = tm.l2Node.TransactionByHash(ctx, signedTx.Hash())
+	if err == nil {
+		// The same no-op tx was already sent
+		return nil
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return err
+	}
+	if err := tm.l2Node.SendTransaction(ctx, signedTx); err != nil {
+		return err
+	}
+	log.Infof("rollupID: %d, nonce gap %d of %s filled with the no-op tx %s", tm.rollupID, nonce, signer.String(), signedTx.Hash().String())
+	metrics.NonceGapFilled(tm.rollupID)
+	return nil
+}
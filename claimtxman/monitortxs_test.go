@@ -0,0 +1,83 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/go-ethereum/common"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReplaceable(t *testing.T) {
+	now := time.Now()
+	tm := &MonitorTxs{
+		cfg: Config{
+			TxReplacement: ConfigTxReplacement{
+				Enabled:           true,
+				Timeout:           types.NewDuration(time.Minute),
+				FeeBumpPercentage: 10,
+				MaxBumps:          2,
+			},
+		},
+	}
+	sentAt := now.Add(-time.Minute)
+	mTx := ctmtypes.MonitoredTx{SentAt: &sentAt, Bumps: 1}
+	require.True(t, tm.isReplaceable(mTx, now))
+
+	// The tx has not been pending long enough
+	require.False(t, tm.isReplaceable(mTx, now.Add(-time.Second)))
+
+	// The tx was never sent
+	require.False(t, tm.isReplaceable(ctmtypes.MonitoredTx{}, now))
+
+	// The tx reached the maximum bumps
+	mTx.Bumps = 2
+	require.False(t, tm.isReplaceable(mTx, now))
+
+	// The replacement is disabled
+	mTx.Bumps = 0
+	tm.cfg.TxReplacement.Enabled = false
+	require.False(t, tm.isReplaceable(mTx, now))
+}
+
+func TestReserveNonceInPriorityOrder(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	signer := common.HexToAddress("0x1")
+	vip := common.HexToAddress("0x2")
+	user := common.HexToAddress("0x3")
+	storage := mock_txcompressor.NewNonceStorage(t)
+	client := mock_txcompressor.NewNonceClient(t)
+	priority, err := NewClaimPrioritizer(ConfigPriority{AgeWeight: 1, VIPAddresses: []common.Address{vip}, VIPBonus: 1000})
+	require.NoError(t, err)
+	tm := &MonitorTxs{nonces: NewNonceAllocator(storage, client, 1), priority: priority}
+
+	// The claim to the vip address is created later but it has a higher priority
+	mTxs := []ctmtypes.MonitoredTx{
+		{DepositID: 1, From: signer, CreatedAt: now.Add(-time.Minute), Data: claimData(t, "claimAsset", common.Address{}, user, big.NewInt(1))},
+		{DepositID: 2, From: signer, CreatedAt: now, Data: claimData(t, "claimAsset", common.Address{}, vip, big.NewInt(1))},
+	}
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(5), nil).Times(2)
+	storage.EXPECT().ReserveNonce(ctx, uint32(1), signer, uint64(5), mock.Anything).Return(uint64(5), nil).Once()
+	storage.EXPECT().ReserveNonce(ctx, uint32(1), signer, uint64(5), mock.Anything).Return(uint64(6), nil).Once()
+	tm.priority.Sort(now, mTxs)
+	for i := range mTxs {
+		require.NoError(t, tm.reserveNonce(ctx, &mTxs[i], nil, log.WithFields("monitoredTx", mTxs[i].DepositID)))
+	}
+	require.Equal(t, []uint64{2, 1}, getDepositIDs(mTxs))
+	require.Equal(t, uint64(5), mTxs[0].Nonce)
+	require.Equal(t, uint64(6), mTxs[1].Nonce)
+	require.True(t, mTxs[0].NonceReserved)
+	require.True(t, mTxs[1].NonceReserved)
+
+	// The nonce is reserved only once
+	require.NoError(t, tm.reserveNonce(ctx, &mTxs[0], nil, log.WithFields("monitoredTx", mTxs[0].DepositID)))
+	require.Equal(t, uint64(5), mTxs[0].Nonce)
+}
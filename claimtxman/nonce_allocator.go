@@ -0,0 +1,118 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fiwallets/go-ethereum/common"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+// maxNonceGapsPerCycle bounds the nonce gaps filled in each monitoring cycle
+const maxNonceGapsPerCycle = 10
+
+type NonceStorage interface {
+	ReserveNonce(ctx context.Context, networkID uint32, signer common.Address, minNonce uint64, dbTx pgx.Tx) (uint64, error)
+	GetNextNonce(ctx context.Context, networkID uint32, signer common.Address, dbTx pgx.Tx) (uint64, error)
+	GetClaimTxsByStatus(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, rollupID uint32, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error)
+}
+
+type NonceClient interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceAllocator allocates the nonces of the claim signers of a network. The nonces are reserved
+// in the database, so they are never reused after a restart or by other claim tx managers using
+// the same signer.
+type NonceAllocator struct {
+	storage   NonceStorage
+	client    NonceClient
+	networkID uint32
+}
+
+// NewNonceAllocator creates a new nonce allocator for the claim signers of a network.
+func NewNonceAllocator(storage interface{}, client NonceClient, networkID uint32) *NonceAllocator {
+	return &NonceAllocator{
+		storage:   storage.(NonceStorage),
+		client:    client,
+		networkID: networkID,
+	}
+}
+
+// ReserveNonce reserves the next nonce of the signer. It must be called with the dbTx that stores
+// the monitored tx using the nonce, so the nonce is released if the monitored tx is not stored.
+func (a *NonceAllocator) ReserveNonce(ctx context.Context, signer common.Address, dbTx pgx.Tx) (uint64, error) {
+	// The nonces used outside the allocator are skipped
+	pendingNonce, err := a.client.PendingNonceAt(ctx, signer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the pending nonce of %s: %v", signer.String(), err)
+	}
+	return a.storage.ReserveNonce(ctx, a.networkID, signer, pendingNonce, dbTx)
+}
+
+// IsNonceConsumed returns whether a tx of the signer with the nonce was already mined.
+func (a *NonceAllocator) IsNonceConsumed(ctx context.Context, signer common.Address, nonce uint64) (bool, error) {
+	minedNonce, err := a.client.NonceAt(ctx, signer, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the nonce of %s: %v", signer.String(), err)
+	}
+	return nonce < minedNonce, nil
+}
+
+// UnminedNonces returns the number of nonces reserved for the signer that are not mined yet.
+func (a *NonceAllocator) UnminedNonces(ctx context.Context, signer common.Address, dbTx pgx.Tx) (uint64, error) {
+	nextNonce, err := a.storage.GetNextNonce(ctx, a.networkID, signer, dbTx)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get the next nonce of %s: %v", signer.String(), err)
+	}
+	minedNonce, err := a.client.NonceAt(ctx, signer, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the nonce of %s: %v", signer.String(), err)
+	}
+	if nextNonce <= minedNonce {
+		return 0, nil
+	}
+	return nextNonce - minedNonce, nil
+}
+
+// NonceGaps returns the nonces reserved for the signer that are not in the pool nor held by a
+// monitored tx to be sent, because their claims were abandoned. The txs with higher nonces are
+// not mined until txs with these nonces are sent.
+func (a *NonceAllocator) NonceGaps(ctx context.Context, signer common.Address, dbTx pgx.Tx) ([]uint64, error) {
+	nextNonce, err := a.storage.GetNextNonce(ctx, a.networkID, signer, dbTx)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the next nonce of %s: %v", signer.String(), err)
+	}
+	// The monitored txs are read after the next nonce, so the ones stored meanwhile hold their nonces
+	mTxs, err := a.storage.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}, a.networkID, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created monitored txs: %v", err)
+	}
+	pendingNonce, err := a.client.PendingNonceAt(ctx, signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the pending nonce of %s: %v", signer.String(), err)
+	}
+
+	held := make(map[uint64]bool, len(mTxs))
+	for _, mTx := range mTxs {
+		if mTx.From == signer && mTx.NonceReserved {
+			held[mTx.Nonce] = true
+		}
+	}
+	var gaps []uint64
+	for nonce := pendingNonce; nonce < nextNonce && len(gaps) < maxNonceGapsPerCycle; nonce++ {
+		if !held[nonce] {
+			gaps = append(gaps, nonce)
+		}
+	}
+	return gaps, nil
+}
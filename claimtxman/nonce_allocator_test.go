@@ -0,0 +1,119 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/common"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceAllocatorReserveNonce(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewNonceStorage(t)
+	client := mock_txcompressor.NewNonceClient(t)
+	a := NewNonceAllocator(storage, client, 1)
+	signer := common.HexToAddress("0x1")
+
+	// The nonces already in the pool are not reserved
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(7), nil).Once()
+	storage.EXPECT().ReserveNonce(ctx, uint32(1), signer, uint64(7), mock.Anything).Return(uint64(9), nil).Once()
+	nonce, err := a.ReserveNonce(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), nonce)
+
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(0), errors.New("failure")).Once()
+	_, err = a.ReserveNonce(ctx, signer, nil)
+	require.Error(t, err)
+}
+
+func TestNonceAllocatorIsNonceConsumed(t *testing.T) {
+	ctx := context.Background()
+	client := mock_txcompressor.NewNonceClient(t)
+	a := NewNonceAllocator(mock_txcompressor.NewNonceStorage(t), client, 1)
+	signer := common.HexToAddress("0x1")
+
+	client.EXPECT().NonceAt(ctx, signer, mock.Anything).Return(uint64(5), nil).Times(2)
+	consumed, err := a.IsNonceConsumed(ctx, signer, 4)
+	require.NoError(t, err)
+	require.True(t, consumed)
+	consumed, err = a.IsNonceConsumed(ctx, signer, 5)
+	require.NoError(t, err)
+	require.False(t, consumed)
+}
+
+func TestNonceAllocatorNonceGaps(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewNonceStorage(t)
+	client := mock_txcompressor.NewNonceClient(t)
+	a := NewNonceAllocator(storage, client, 1)
+	signer := common.HexToAddress("0x1")
+	statuses := []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}
+
+	// Without reserved nonces there are no gaps
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(0), gerror.ErrStorageNotFound).Once()
+	gaps, err := a.NonceGaps(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+
+	// The nonces reserved after the pending nonce and not held by created monitored txs of the signer are gaps.
+	// The monitored txs never sent don't hold a nonce
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(8), nil).Once()
+	storage.EXPECT().GetClaimTxsByStatus(ctx, statuses, uint32(1), mock.Anything).Return([]ctmtypes.MonitoredTx{
+		{From: signer, Nonce: 4, NonceReserved: true},
+		{From: signer, Nonce: 6, NonceReserved: true},
+		{From: signer, Nonce: 7},
+		{From: common.HexToAddress("0x2"), Nonce: 5, NonceReserved: true},
+	}, nil).Once()
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(3), nil).Once()
+	gaps, err = a.NonceGaps(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 5, 7}, gaps)
+
+	// The gaps are bounded
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(100), nil).Once()
+	storage.EXPECT().GetClaimTxsByStatus(ctx, statuses, uint32(1), mock.Anything).Return(nil, nil).Once()
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(0), nil).Once()
+	gaps, err = a.NonceGaps(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Len(t, gaps, maxNonceGapsPerCycle)
+
+	// The pending txs are not gaps
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(8), nil).Once()
+	storage.EXPECT().GetClaimTxsByStatus(ctx, statuses, uint32(1), mock.Anything).Return(nil, nil).Once()
+	client.EXPECT().PendingNonceAt(ctx, signer).Return(uint64(8), nil).Once()
+	gaps, err = a.NonceGaps(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+}
+
+func TestNonceAllocatorUnminedNonces(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewNonceStorage(t)
+	client := mock_txcompressor.NewNonceClient(t)
+	a := NewNonceAllocator(storage, client, 1)
+	signer := common.HexToAddress("0x1")
+
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(0), gerror.ErrStorageNotFound).Once()
+	unmined, err := a.UnminedNonces(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Zero(t, unmined)
+
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(8), nil).Once()
+	client.EXPECT().NonceAt(ctx, signer, mock.Anything).Return(uint64(5), nil).Once()
+	unmined, err = a.UnminedNonces(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), unmined)
+
+	// The nonces used outside the allocator are mined
+	storage.EXPECT().GetNextNonce(ctx, uint32(1), signer, mock.Anything).Return(uint64(8), nil).Once()
+	client.EXPECT().NonceAt(ctx, signer, mock.Anything).Return(uint64(10), nil).Once()
+	unmined, err = a.UnminedNonces(ctx, signer, nil)
+	require.NoError(t, err)
+	require.Zero(t, unmined)
+}
@@ -2,6 +2,7 @@ package claimtxman
 
 import (
 	"fmt"
+	"math/big"
 
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/utils"
@@ -9,6 +10,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 )
 
+// bigIntComparer compares the big ints by value, cmp can't compare their unexported fields
+var bigIntComparer = cmp.Comparer(func(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+})
+
 type GrupedTxs struct {
 	// Status can be Compressing or Claiming
 	Status ctmtypes.MonitoredTxStatus
@@ -24,6 +33,8 @@ type PendingTxs struct {
 	GroupTx              map[uint64]*ctmtypes.MonitoredTxGroup
 	TxCandidatesForGroup []ctmtypes.MonitoredTx
 	LastGroupTxID        uint64
+	// ClaimedExternallyTxs are the candidates removed because their deposits were claimed by another tx
+	ClaimedExternallyTxs []ctmtypes.MonitoredTx
 }
 
 func (m *PendingTxs) IsEmpty() bool {
@@ -38,6 +49,19 @@ func (m *PendingTxs) GenerateNewGroupID() uint64 {
 func (m *PendingTxs) addTxCandidatesForGroup(tx ctmtypes.MonitoredTx) {
 	m.TxCandidatesForGroup = append(m.TxCandidatesForGroup, tx)
 }
+// setClaimedExternally removes the candidate from the candidates to be grouped as its deposit was
+// claimed by another tx.
+func (m *PendingTxs) setClaimedExternally(tx ctmtypes.MonitoredTx) {
+	for i := range m.TxCandidatesForGroup {
+		if m.TxCandidatesForGroup[i].DepositID == tx.DepositID {
+			m.TxCandidatesForGroup = append(m.TxCandidatesForGroup[:i], m.TxCandidatesForGroup[i+1:]...)
+			break
+		}
+	}
+	tx.Status = ctmtypes.MonitoredTxStatusClaimedExternally
+	m.ClaimedExternallyTxs = append(m.ClaimedExternallyTxs, tx)
+}
+
 func (m *PendingTxs) addTxToGroup(group uint64, tx ctmtypes.MonitoredTx) {
 	if _, ok := m.GroupTx[group]; !ok {
 		m.GroupTx[group] = &ctmtypes.MonitoredTxGroup{}
@@ -104,7 +128,7 @@ func GenerateStoreUpdate(oldState, newState PendingTxs, timeProvider utils.TimeP
 			group.DbEntry.DepositIDs = group.GetTxsDepositID()
 			result.AddGroup(group.DbEntry)
 		} else {
-			if cmp.Equal(oldGroup.DbEntry, group.DbEntry) {
+			if cmp.Equal(oldGroup.DbEntry, group.DbEntry, bigIntComparer) {
 				continue
 			}
 			group.DbEntry.UpdatedAt = timeProvider.Now()
@@ -121,7 +145,7 @@ func GenerateStoreUpdate(oldState, newState PendingTxs, timeProvider utils.TimeP
 					return nil, fmt.Errorf("tx with depositID %d not found in old state", tx.DepositID)
 				}
 				newTx := tx
-				if !cmp.Equal(*oldTx, newTx) {
+				if !cmp.Equal(*oldTx, newTx, bigIntComparer) {
 					result.UpdateTx(tx)
 				}
 			}
@@ -132,5 +156,8 @@ func GenerateStoreUpdate(oldState, newState PendingTxs, timeProvider utils.TimeP
 			}
 		}
 	}
+	for _, tx := range newState.ClaimedExternallyTxs {
+		result.UpdateTx(tx)
+	}
 	return result, nil
 }
@@ -0,0 +1,181 @@
+package claimtxman
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/utils"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// PolicyRuleMessage rejects the messages not sent from an authorized address nor to an allowed contract
+	PolicyRuleMessage = "message"
+	// PolicyRuleDestination rejects the deposits to a denied or not allowed destination address
+	PolicyRuleDestination = "destination"
+	// PolicyRuleToken rejects the deposits of a disabled or not listed token
+	PolicyRuleToken = "token"
+	// PolicyRuleMinAmount rejects the deposits below the minimum amount of the token
+	PolicyRuleMinAmount = "min_amount"
+	// PolicyRuleBudget rejects the deposits once the daily budget of the token or destination is spent
+	PolicyRuleBudget = "budget"
+)
+
+type PolicyStorage interface {
+	GetTokenClaimsFee(ctx context.Context, originalNetwork uint32, originalAddress common.Address, destinationNetwork uint32, since time.Time, dbTx pgx.Tx) (*big.Int, error)
+	GetDestinationClaimsFee(ctx context.Context, destinationAddress common.Address, destinationNetwork uint32, since time.Time, dbTx pgx.Tx) (*big.Int, error)
+	AddRejectedClaim(ctx context.Context, depositID uint64, rule, reason string, dbTx pgx.Tx) (bool, error)
+	DeleteRejectedClaim(ctx context.Context, depositID uint64, dbTx pgx.Tx) error
+	GetRejectedL1Deposits(ctx context.Context, rule string, destinationNetwork uint32, before time.Time, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+}
+
+// ClaimRejection is the reason a deposit is not claimed
+type ClaimRejection struct {
+	// Rule is the policy rule that rejected the deposit
+	Rule string
+	// Reason is the description of the rejection shown by the API
+	Reason string
+}
+
+type tokenPolicy struct {
+	ConfigTokenPolicy
+	minAmount *big.Int
+}
+
+type tokenKey struct {
+	network uint32
+	address common.Address
+}
+
+// ClaimPolicy decides which deposits the claim tx manager claims. The daily budgets are checked
+// against the fees of the claims finished during the UTC day, so the claims in flight can exceed them.
+// The deposits rejected by the budgets are deferred until the next UTC day, not rejected forever.
+type ClaimPolicy struct {
+	cfg                      ConfigPolicies
+	storage                  PolicyStorage
+	timeProvider             utils.TimeProvider
+	networkID                uint32
+	tokens                   map[tokenKey]tokenPolicy
+	authorizedMessageSenders map[common.Address]struct{}
+	messageContracts         map[common.Address]struct{}
+	allowedDestinations      map[common.Address]struct{}
+	deniedDestinations       map[common.Address]struct{}
+}
+
+// NewClaimPolicy creates the claim policy of the deposits to the network. The messages sent from
+// the authorized message addresses are claimed as before the policies existed.
+func NewClaimPolicy(cfg ConfigPolicies, authorizedMessageAddresses []common.Address, storage interface{}, timeProvider utils.TimeProvider, networkID uint32) (*ClaimPolicy, error) {
+	p := &ClaimPolicy{
+		cfg:                      cfg,
+		storage:                  storage.(PolicyStorage),
+		timeProvider:             timeProvider,
+		networkID:                networkID,
+		tokens:                   make(map[tokenKey]tokenPolicy, len(cfg.Tokens)),
+		authorizedMessageSenders: addressSet(authorizedMessageAddresses),
+		messageContracts:         addressSet(cfg.AllowedMessageContracts),
+		allowedDestinations:      addressSet(cfg.AllowedDestinationAddresses),
+		deniedDestinations:       addressSet(cfg.DeniedDestinationAddresses),
+	}
+	for _, token := range cfg.Tokens {
+		key := tokenKey{network: token.OriginalNetwork, address: token.OriginalAddress}
+		if _, found := p.tokens[key]; found {
+			return nil, fmt.Errorf("duplicated claim policy of the token %s of network %d", token.OriginalAddress.String(), token.OriginalNetwork)
+		}
+		policy := tokenPolicy{ConfigTokenPolicy: token}
+		if token.MinAmount != "" {
+			minAmount, ok := new(big.Int).SetString(token.MinAmount, 10) //nolint:gomnd
+			if !ok || minAmount.Sign() < 0 {
+				return nil, fmt.Errorf("invalid MinAmount %s of the claim policy of the token %s of network %d", token.MinAmount, token.OriginalAddress.String(), token.OriginalNetwork)
+			}
+			policy.minAmount = minAmount
+		}
+		p.tokens[key] = policy
+	}
+	return p, nil
+}
+
+func addressSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		set[addr] = struct{}{}
+	}
+	return set
+}
+
+// Check returns the rejection of the deposit by the policies, nil if the deposit can be claimed.
+func (p *ClaimPolicy) Check(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (*ClaimRejection, error) {
+	if deposit.LeafType == LeafTypeMessage {
+		_, authorized := p.authorizedMessageSenders[deposit.OriginalAddress]
+		_, allowed := p.messageContracts[deposit.DestinationAddress]
+		if !authorized && !allowed {
+			return &ClaimRejection{Rule: PolicyRuleMessage, Reason: fmt.Sprintf("message sender %s is not authorized and destination contract %s is not allowed",
+				deposit.OriginalAddress.String(), deposit.DestinationAddress.String())}, nil
+		}
+	}
+	if _, denied := p.deniedDestinations[deposit.DestinationAddress]; denied {
+		return &ClaimRejection{Rule: PolicyRuleDestination, Reason: fmt.Sprintf("destination address %s is denied", deposit.DestinationAddress.String())}, nil
+	}
+	if _, allowed := p.allowedDestinations[deposit.DestinationAddress]; len(p.allowedDestinations) > 0 && !allowed {
+		return &ClaimRejection{Rule: PolicyRuleDestination, Reason: fmt.Sprintf("destination address %s is not allowed", deposit.DestinationAddress.String())}, nil
+	}
+
+	since := p.today()
+	// The original address of a message is its sender, not a token
+	if deposit.LeafType != LeafTypeMessage {
+		token, found := p.tokens[tokenKey{network: deposit.OriginalNetwork, address: deposit.OriginalAddress}]
+		if !found && p.cfg.OnlyListedTokens || found && token.Disabled {
+			return &ClaimRejection{Rule: PolicyRuleToken, Reason: fmt.Sprintf("token %s of network %d is not sponsored",
+				deposit.OriginalAddress.String(), deposit.OriginalNetwork)}, nil
+		}
+		if found && token.minAmount != nil && deposit.Amount.Cmp(token.minAmount) < 0 {
+			return &ClaimRejection{Rule: PolicyRuleMinAmount, Reason: fmt.Sprintf("amount %s is below the minimum %s of the token",
+				deposit.Amount.String(), token.minAmount.String())}, nil
+		}
+		if found && token.DailyBudget > 0 {
+			fee, err := p.storage.GetTokenClaimsFee(ctx, deposit.OriginalNetwork, deposit.OriginalAddress, p.networkID, since, dbTx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the claims fee of the token %s of network %d: %w", deposit.OriginalAddress.String(), deposit.OriginalNetwork, err)
+			}
+			if fee.Cmp(new(big.Int).SetUint64(token.DailyBudget)) >= 0 {
+				return &ClaimRejection{Rule: PolicyRuleBudget, Reason: fmt.Sprintf("daily budget %d of the token is spent", token.DailyBudget)}, nil
+			}
+		}
+	}
+	if p.cfg.DailyBudgetPerDestination > 0 {
+		fee, err := p.storage.GetDestinationClaimsFee(ctx, deposit.DestinationAddress, p.networkID, since, dbTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the claims fee of the destination address %s: %w", deposit.DestinationAddress.String(), err)
+		}
+		if fee.Cmp(new(big.Int).SetUint64(p.cfg.DailyBudgetPerDestination)) >= 0 {
+			return &ClaimRejection{Rule: PolicyRuleBudget, Reason: fmt.Sprintf("daily budget %d of the destination address is spent", p.cfg.DailyBudgetPerDestination)}, nil
+		}
+	}
+	return nil, nil
+}
+
+// Reject records the rejection of the deposit, so the API can show why it is not claimed. It returns
+// false if the deposit was already rejected with the same reason.
+func (p *ClaimPolicy) Reject(ctx context.Context, deposit *etherman.Deposit, rejection *ClaimRejection, dbTx pgx.Tx) (bool, error) {
+	return p.storage.AddRejectedClaim(ctx, deposit.Id, rejection.Rule, rejection.Reason, dbTx)
+}
+
+// Accept removes the previous rejection of the deposit, as its claim tx is going to be created.
+func (p *ClaimPolicy) Accept(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	return p.storage.DeleteRejectedClaim(ctx, deposit.Id, dbTx)
+}
+
+// Deferred returns the L1 deposits to the network rejected by the daily budgets before the current
+// UTC day, so they are checked again once a day. The deposits from other L2s don't need it, they
+// are checked again with every GER until they are claimed.
+func (p *ClaimPolicy) Deferred(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	return p.storage.GetRejectedL1Deposits(ctx, PolicyRuleBudget, p.networkID, p.today(), dbTx)
+}
+
+// today returns the start of the current UTC day, when the daily budgets are reset
+func (p *ClaimPolicy) today() time.Time {
+	return p.timeProvider.Now().UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,132 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/common"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/utils"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	policyToken       = common.HexToAddress("0x1")
+	policyDestination = common.HexToAddress("0x2")
+	policyContract    = common.HexToAddress("0x3")
+	policySender      = common.HexToAddress("0x4")
+)
+
+func TestNewClaimPolicy(t *testing.T) {
+	timeProvider := utils.NewTimeProviderSystemLocalTime()
+	_, err := NewClaimPolicy(ConfigPolicies{Tokens: []ConfigTokenPolicy{{OriginalAddress: policyToken, MinAmount: "1000000000000000000000"}}}, nil, mock_txcompressor.NewPolicyStorage(t), timeProvider, 1)
+	require.NoError(t, err)
+	_, err = NewClaimPolicy(ConfigPolicies{Tokens: []ConfigTokenPolicy{{OriginalAddress: policyToken, MinAmount: "1e18"}}}, nil, mock_txcompressor.NewPolicyStorage(t), timeProvider, 1)
+	require.Error(t, err)
+	_, err = NewClaimPolicy(ConfigPolicies{Tokens: []ConfigTokenPolicy{{OriginalAddress: policyToken}, {OriginalAddress: policyToken}}}, nil, mock_txcompressor.NewPolicyStorage(t), timeProvider, 1)
+	require.Error(t, err)
+}
+
+func TestClaimPolicyCheck(t *testing.T) {
+	ctx := context.Background()
+	cfg := ConfigPolicies{
+		Tokens: []ConfigTokenPolicy{
+			{OriginalNetwork: 0, OriginalAddress: common.Address{}, MinAmount: "100"},
+			{OriginalNetwork: 0, OriginalAddress: policyToken, Disabled: true},
+		},
+		OnlyListedTokens:           true,
+		DeniedDestinationAddresses: []common.Address{common.HexToAddress("0x5")},
+		AllowedMessageContracts:    []common.Address{policyContract},
+	}
+	p, err := NewClaimPolicy(cfg, []common.Address{policySender}, mock_txcompressor.NewPolicyStorage(t), utils.NewTimeProviderSystemLocalTime(), 1)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		deposit etherman.Deposit
+		rule    string
+	}{
+		{"ether", etherman.Deposit{Amount: big.NewInt(100), DestinationAddress: policyDestination}, ""},
+		{"ether below the minimum", etherman.Deposit{Amount: big.NewInt(99), DestinationAddress: policyDestination}, PolicyRuleMinAmount},
+		{"disabled token", etherman.Deposit{OriginalAddress: policyToken, Amount: big.NewInt(100), DestinationAddress: policyDestination}, PolicyRuleToken},
+		{"not listed token", etherman.Deposit{OriginalNetwork: 1, OriginalAddress: policyToken, Amount: big.NewInt(100), DestinationAddress: policyDestination}, PolicyRuleToken},
+		{"denied destination", etherman.Deposit{Amount: big.NewInt(100), DestinationAddress: common.HexToAddress("0x5")}, PolicyRuleDestination},
+		{"message from an authorized sender", etherman.Deposit{LeafType: LeafTypeMessage, OriginalAddress: policySender, Amount: big.NewInt(0), DestinationAddress: policyDestination}, ""},
+		{"message to an allowed contract", etherman.Deposit{LeafType: LeafTypeMessage, OriginalAddress: policyToken, Amount: big.NewInt(0), DestinationAddress: policyContract}, ""},
+		{"message not allowed", etherman.Deposit{LeafType: LeafTypeMessage, OriginalAddress: policyToken, Amount: big.NewInt(0), DestinationAddress: policyDestination}, PolicyRuleMessage},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rejection, err := p.Check(ctx, &tc.deposit, nil)
+			require.NoError(t, err)
+			if tc.rule == "" {
+				require.Nil(t, rejection)
+				return
+			}
+			require.NotNil(t, rejection)
+			require.Equal(t, tc.rule, rejection.Rule)
+			require.NotEmpty(t, rejection.Reason)
+		})
+	}
+
+	// Only the allowed destinations are claimed
+	cfg.AllowedDestinationAddresses = []common.Address{policyContract}
+	p, err = NewClaimPolicy(cfg, []common.Address{policySender}, mock_txcompressor.NewPolicyStorage(t), utils.NewTimeProviderSystemLocalTime(), 1)
+	require.NoError(t, err)
+	rejection, err := p.Check(ctx, &etherman.Deposit{Amount: big.NewInt(100), DestinationAddress: policyDestination}, nil)
+	require.NoError(t, err)
+	require.Equal(t, PolicyRuleDestination, rejection.Rule)
+	rejection, err = p.Check(ctx, &etherman.Deposit{Amount: big.NewInt(100), DestinationAddress: policyContract}, nil)
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+}
+
+func TestClaimPolicyBudgets(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewPolicyStorage(t)
+	cfg := ConfigPolicies{
+		Tokens:                    []ConfigTokenPolicy{{OriginalAddress: policyToken, DailyBudget: 1000}},
+		DailyBudgetPerDestination: 500,
+	}
+	timeProvider := utils.TimeProviderFixedTime{FixedTime: time.Date(2024, 3, 1, 15, 4, 5, 0, time.UTC)}
+	p, err := NewClaimPolicy(cfg, nil, storage, timeProvider, 1)
+	require.NoError(t, err)
+	deposit := &etherman.Deposit{Id: 7, OriginalAddress: policyToken, Amount: big.NewInt(1), DestinationAddress: policyDestination}
+	// The budgets are spent during the UTC day
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	storage.EXPECT().GetTokenClaimsFee(mock.Anything, uint32(0), policyToken, uint32(1), day, mock.Anything).Return(big.NewInt(999), nil).Once()
+	storage.EXPECT().GetDestinationClaimsFee(mock.Anything, policyDestination, uint32(1), day, mock.Anything).Return(big.NewInt(499), nil).Once()
+	rejection, err := p.Check(ctx, deposit, nil)
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+
+	storage.EXPECT().GetTokenClaimsFee(mock.Anything, uint32(0), policyToken, uint32(1), day, mock.Anything).Return(big.NewInt(1000), nil).Once()
+	rejection, err = p.Check(ctx, deposit, nil)
+	require.NoError(t, err)
+	require.Equal(t, PolicyRuleBudget, rejection.Rule)
+
+	storage.EXPECT().GetTokenClaimsFee(mock.Anything, uint32(0), policyToken, uint32(1), day, mock.Anything).Return(big.NewInt(0), nil).Once()
+	storage.EXPECT().GetDestinationClaimsFee(mock.Anything, policyDestination, uint32(1), day, mock.Anything).Return(big.NewInt(500), nil).Once()
+	rejection, err = p.Check(ctx, deposit, nil)
+	require.NoError(t, err)
+	require.Equal(t, PolicyRuleBudget, rejection.Rule)
+
+	storage.EXPECT().AddRejectedClaim(mock.Anything, uint64(7), PolicyRuleBudget, rejection.Reason, mock.Anything).Return(true, nil).Once()
+	rejected, err := p.Reject(ctx, deposit, rejection, nil)
+	require.NoError(t, err)
+	require.True(t, rejected)
+
+	// The deposits rejected by the budgets before today are checked again
+	storage.EXPECT().GetRejectedL1Deposits(mock.Anything, PolicyRuleBudget, uint32(1), day, mock.Anything).Return([]*etherman.Deposit{deposit}, nil).Once()
+	deferred, err := p.Deferred(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*etherman.Deposit{deposit}, deferred)
+
+	storage.EXPECT().DeleteRejectedClaim(mock.Anything, uint64(7), mock.Anything).Return(nil).Once()
+	require.NoError(t, p.Accept(ctx, deposit, nil))
+}
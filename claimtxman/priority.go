@@ -0,0 +1,111 @@
+package claimtxman
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/common"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/log"
+)
+
+// ClaimPrioritizer orders the pending claims. The priority of a claim is computed from its age and
+// from its calldata: the amount normalized by the token, the destination address and whether it
+// is a message. The claims pending for longer than the max waiting time go before any other, the
+// oldest first, so the low priority claims are not starved.
+type ClaimPrioritizer struct {
+	cfg      ConfigPriority
+	composer *ComposeCompressClaim
+	units    map[tokenKey]*big.Float
+	vips     map[common.Address]struct{}
+}
+
+// NewClaimPrioritizer creates a new claim prioritizer.
+func NewClaimPrioritizer(cfg ConfigPriority) (*ClaimPrioritizer, error) {
+	composer, err := NewComposeCompressClaim()
+	if err != nil {
+		return nil, err
+	}
+	p := &ClaimPrioritizer{
+		cfg:      cfg,
+		composer: composer,
+		units:    make(map[tokenKey]*big.Float, len(cfg.Tokens)),
+		vips:     addressSet(cfg.VIPAddresses),
+	}
+	for _, token := range cfg.Tokens {
+		unit, ok := new(big.Float).SetString(token.UnitAmount)
+		if !ok || unit.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid UnitAmount %s of the priority of the token %s of network %d", token.UnitAmount, token.OriginalAddress.String(), token.OriginalNetwork)
+		}
+		p.units[tokenKey{network: token.OriginalNetwork, address: token.OriginalAddress}] = unit
+	}
+	return p, nil
+}
+
+// Sort sorts the monitored txs from the highest to the lowest priority at the given time. The txs
+// with the same priority keep their order.
+func (p *ClaimPrioritizer) Sort(now time.Time, mTxs []ctmtypes.MonitoredTx) {
+	starving := make([]bool, len(mTxs))
+	priorities := make([]float64, len(mTxs))
+	for i := range mTxs {
+		starving[i] = p.isStarving(now, mTxs[i])
+		priorities[i] = p.priority(now, mTxs[i])
+	}
+	sort.Stable(&prioritizedTxs{mTxs: mTxs, starving: starving, priorities: priorities})
+}
+
+func (p *ClaimPrioritizer) isStarving(now time.Time, mTx ctmtypes.MonitoredTx) bool {
+	return p.cfg.MaxWaitingTime.Duration > 0 && now.Sub(mTx.CreatedAt) >= p.cfg.MaxWaitingTime.Duration
+}
+
+func (p *ClaimPrioritizer) priority(now time.Time, mTx ctmtypes.MonitoredTx) float64 {
+	priority := p.cfg.AgeWeight * now.Sub(mTx.CreatedAt).Minutes()
+	if len(mTx.Data) < 4 { //nolint:gomnd
+		return priority
+	}
+	params, err := p.composer.extractParams(mTx.Data)
+	if err != nil {
+		log.Warnf("failed to decode the claim of deposit id %d to compute its priority: %v", mTx.DepositID, err)
+		return priority
+	}
+	if _, vip := p.vips[params.destinationAddress]; vip {
+		priority += p.cfg.VIPBonus
+	}
+	if params.isMessage {
+		return priority + p.cfg.MessageBonus
+	}
+	if unit, found := p.units[tokenKey{network: params.originNetwork, address: params.originTokenAddress}]; found {
+		amount, _ := new(big.Float).Quo(new(big.Float).SetInt(params.amount), unit).Float64()
+		priority += p.cfg.AmountWeight * amount
+	}
+	return priority
+}
+
+// prioritizedTxs sorts the starving txs first, by age, and then the rest by priority
+type prioritizedTxs struct {
+	mTxs       []ctmtypes.MonitoredTx
+	starving   []bool
+	priorities []float64
+}
+
+func (s *prioritizedTxs) Len() int {
+	return len(s.mTxs)
+}
+
+func (s *prioritizedTxs) Less(i, j int) bool {
+	if s.starving[i] != s.starving[j] {
+		return s.starving[i]
+	}
+	if s.starving[i] {
+		return s.mTxs[i].CreatedAt.Before(s.mTxs[j].CreatedAt)
+	}
+	return s.priorities[i] > s.priorities[j]
+}
+
+func (s *prioritizedTxs) Swap(i, j int) {
+	s.mTxs[i], s.mTxs[j] = s.mTxs[j], s.mTxs[i]
+	s.starving[i], s.starving[j] = s.starving[j], s.starving[i]
+	s.priorities[i], s.priorities[j] = s.priorities[j], s.priorities[i]
+}
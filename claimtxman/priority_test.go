@@ -0,0 +1,102 @@
+package claimtxman
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/go-ethereum/common"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/test/mocksmartcontracts/polygonzkevmbridge"
+	"github.com/stretchr/testify/require"
+)
+
+func claimData(t *testing.T, method string, token, destination common.Address, amount *big.Int) []byte {
+	smcAbi, err := polygonzkevmbridge.PolygonzkevmbridgeMetaData.GetAbi()
+	require.NoError(t, err)
+	data, err := smcAbi.Pack(method, [32][32]byte{}, [32][32]byte{}, big.NewInt(0), [32]byte{}, [32]byte{},
+		uint32(0), token, uint32(1), destination, amount, []byte{})
+	require.NoError(t, err)
+	return data
+}
+
+func TestNewClaimPrioritizer(t *testing.T) {
+	_, err := NewClaimPrioritizer(ConfigPriority{Tokens: []ConfigTokenPriority{{UnitAmount: "1000000000000000000"}}})
+	require.NoError(t, err)
+	_, err = NewClaimPrioritizer(ConfigPriority{Tokens: []ConfigTokenPriority{{UnitAmount: "0"}}})
+	require.Error(t, err)
+	_, err = NewClaimPrioritizer(ConfigPriority{Tokens: []ConfigTokenPriority{{UnitAmount: "one"}}})
+	require.Error(t, err)
+}
+
+func TestClaimPrioritizerSort(t *testing.T) {
+	now := time.Now()
+	usdc := common.HexToAddress("0x1")
+	vip := common.HexToAddress("0x2")
+	user := common.HexToAddress("0x3")
+	mTxs := func() []ctmtypes.MonitoredTx {
+		return []ctmtypes.MonitoredTx{
+			{DepositID: 1, CreatedAt: now.Add(-3 * time.Minute), Data: claimData(t, "claimAsset", common.Address{}, user, big.NewInt(1e17))},
+			{DepositID: 2, CreatedAt: now.Add(-2 * time.Minute), Data: claimData(t, "claimAsset", usdc, user, big.NewInt(5e9))},
+			{DepositID: 3, CreatedAt: now.Add(-time.Minute), Data: claimData(t, "claimMessage", common.Address{}, user, big.NewInt(0))},
+			{DepositID: 4, CreatedAt: now, Data: claimData(t, "claimAsset", common.Address{}, vip, big.NewInt(1e17))},
+		}
+	}
+
+	// By default the claims are sorted by age
+	p, err := NewClaimPrioritizer(ConfigPriority{AgeWeight: 1})
+	require.NoError(t, err)
+	txs := mTxs()
+	p.Sort(now, txs)
+	require.Equal(t, []uint64{1, 2, 3, 4}, getDepositIDs(txs))
+
+	// The amounts are normalized by token, 0.1 ether is 300 and 5000 usdc is 5000
+	cfg := ConfigPriority{
+		AgeWeight:    1,
+		AmountWeight: 1,
+		Tokens: []ConfigTokenPriority{
+			{OriginalAddress: common.Address{}, UnitAmount: "333333333333333"},
+			{OriginalAddress: usdc, UnitAmount: "1000000"},
+		},
+		VIPAddresses: []common.Address{vip},
+		VIPBonus:     10000,
+		MessageBonus: 1000,
+	}
+	p, err = NewClaimPrioritizer(cfg)
+	require.NoError(t, err)
+	txs = mTxs()
+	p.Sort(now, txs)
+	require.Equal(t, []uint64{4, 2, 3, 1}, getDepositIDs(txs))
+
+	// The claims waiting for too long go first, the oldest first
+	cfg.MaxWaitingTime = types.NewDuration(90 * time.Second)
+	p, err = NewClaimPrioritizer(cfg)
+	require.NoError(t, err)
+	txs = mTxs()
+	p.Sort(now, txs)
+	require.Equal(t, []uint64{1, 2, 4, 3}, getDepositIDs(txs))
+}
+
+func TestGroupsTriggerChooseTxs(t *testing.T) {
+	now := time.Now()
+	user := common.HexToAddress("0x3")
+	candidates := []ctmtypes.MonitoredTx{
+		{DepositID: 1, CreatedAt: now, Data: claimData(t, "claimAsset", common.Address{}, user, big.NewInt(1))},
+		{DepositID: 2, CreatedAt: now, Data: claimData(t, "claimAsset", common.Address{}, user, big.NewInt(3))},
+		{DepositID: 3, CreatedAt: now, Data: claimData(t, "claimAsset", common.Address{}, user, big.NewInt(2))},
+	}
+	cfg := ConfigGroupingClaims{TriggerNumberOfClaims: 3, MaxNumberOfClaimsPerGroup: 2, TriggerRetainedClaimPeriod: types.NewDuration(time.Minute)}
+
+	// Without priority the first candidates are chosen
+	require.Equal(t, []uint64{1, 2}, getDepositIDs(NewGroupsTrigger(cfg, nil).ChooseTxs(now, candidates)))
+
+	p, err := NewClaimPrioritizer(ConfigPriority{AmountWeight: 1, Tokens: []ConfigTokenPriority{{UnitAmount: "1"}}})
+	require.NoError(t, err)
+	trigger := NewGroupsTrigger(cfg, p)
+	require.Equal(t, []uint64{2, 3}, getDepositIDs(trigger.ChooseTxs(now, candidates)))
+	// The candidates keep their order
+	require.Equal(t, []uint64{1, 2, 3}, getDepositIDs(candidates))
+	// Not enough claims to trigger the group
+	require.Nil(t, trigger.ChooseTxs(now, candidates[:2]))
+}
@@ -0,0 +1,156 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/go-ethereum/common"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	// errNoSignerAvailable is returned when all the claim signers are below the minimum balance
+	errNoSignerAvailable = errors.New("no claim signer with enough balance")
+	// errUnknownSigner is returned when a monitored tx was assigned to a signer not configured anymore
+	errUnknownSigner = errors.New("unknown claim signer")
+)
+
+type SignerStorage interface {
+	GetClaimTxsCountBySigner(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, rollupID uint32, dbTx pgx.Tx) (map[common.Address]uint64, error)
+}
+
+type SignerClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// signerState is the state of a signer considered to assign it a claim.
+type signerState struct {
+	auth *bind.TransactOpts
+	// load is the number of claims of the signer in progress
+	load uint64
+	// unminedNonces is the number of nonces reserved for the signer that are not mined yet
+	unminedNonces uint64
+	balance       *big.Int
+}
+
+// SignerPool holds the signers of the claim txs of a network. Each claim is assigned to a signer
+// when it is created and the signer is stored in the monitored tx, so all its txs are sent by the
+// same signer.
+type SignerPool struct {
+	signers    []*bind.TransactOpts
+	byAddress  map[common.Address]*bind.TransactOpts
+	storage    SignerStorage
+	client     SignerClient
+	nonces     *NonceAllocator
+	networkID  uint32
+	minBalance *big.Int
+}
+
+// NewSignerPool creates a new pool with the claim signers of a network.
+func NewSignerPool(signers []*bind.TransactOpts, storage interface{}, client SignerClient, nonces *NonceAllocator, networkID uint32, minBalance *big.Int) (*SignerPool, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("at least one claim signer is required")
+	}
+	byAddress := make(map[common.Address]*bind.TransactOpts, len(signers))
+	for _, auth := range signers {
+		if _, found := byAddress[auth.From]; found {
+			return nil, fmt.Errorf("duplicated claim signer %s", auth.From.String())
+		}
+		byAddress[auth.From] = auth
+	}
+	return &SignerPool{
+		signers:    signers,
+		byAddress:  byAddress,
+		storage:    storage.(SignerStorage),
+		client:     client,
+		nonces:     nonces,
+		networkID:  networkID,
+		minBalance: minBalance,
+	}, nil
+}
+
+// Default returns the first signer. It is the only signer when the claims are compressed.
+func (p *SignerPool) Default() *bind.TransactOpts {
+	return p.signers[0]
+}
+
+// Addresses returns the addresses of the signers.
+func (p *SignerPool) Addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(p.signers))
+	for _, auth := range p.signers {
+		addrs = append(addrs, auth.From)
+	}
+	return addrs
+}
+
+// Signer returns the signer with the address.
+func (p *SignerPool) Signer(addr common.Address) (*bind.TransactOpts, error) {
+	auth, found := p.byAddress[addr]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownSigner, addr.String())
+	}
+	return auth, nil
+}
+
+// SelectSigner chooses the signer of a new claim. The signers below the minimum balance are
+// skipped, then the one with the fewest claims in progress is chosen, then the one with the fewest
+// nonces not mined yet and then the one with the highest balance. A single signer is always chosen.
+func (p *SignerPool) SelectSigner(ctx context.Context, dbTx pgx.Tx) (*bind.TransactOpts, error) {
+	if len(p.signers) == 1 {
+		return p.signers[0], nil
+	}
+	loads, err := p.storage.GetClaimTxsCountBySigner(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}, p.networkID, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the claims of the signers: %v", err)
+	}
+	states := make([]signerState, 0, len(p.signers))
+	for _, auth := range p.signers {
+		balance, err := p.client.BalanceAt(ctx, auth.From, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the balance of %s: %v", auth.From.String(), err)
+		}
+		if balance.Cmp(p.minBalance) < 0 {
+			log.Warnf("networkID: %d, claim signer %s skipped, balance %s below the minimum %s", p.networkID, auth.From.String(), balance.String(), p.minBalance.String())
+			continue
+		}
+		unminedNonces, err := p.nonces.UnminedNonces(ctx, auth.From, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, signerState{auth: auth, load: loads[auth.From], unminedNonces: unminedNonces, balance: balance})
+	}
+	state, found := selectSigner(states)
+	if !found {
+		return nil, errNoSignerAvailable
+	}
+	return state.auth, nil
+}
+
+// selectSigner returns the state of the signer with the fewest claims in progress, then with the
+// fewest nonces not mined yet and then with the highest balance. The first one wins the ties.
+func selectSigner(states []signerState) (signerState, bool) {
+	if len(states) == 0 {
+		return signerState{}, false
+	}
+	best := states[0]
+	for _, s := range states[1:] {
+		switch {
+		case s.load != best.load:
+			if s.load < best.load {
+				best = s
+			}
+		case s.unminedNonces != best.unminedNonces:
+			if s.unminedNonces < best.unminedNonces {
+				best = s
+			}
+		case s.balance.Cmp(best.balance) > 0:
+			best = s
+		}
+	}
+	return best, true
+}
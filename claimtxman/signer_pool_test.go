@@ -0,0 +1,120 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/go-ethereum/common"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigners(addrs ...string) []*bind.TransactOpts {
+	signers := make([]*bind.TransactOpts, 0, len(addrs))
+	for _, addr := range addrs {
+		signers = append(signers, &bind.TransactOpts{From: common.HexToAddress(addr)})
+	}
+	return signers
+}
+
+func TestNewSignerPool(t *testing.T) {
+	storage := mock_txcompressor.NewSignerStorage(t)
+	_, err := NewSignerPool(nil, storage, nil, nil, 1, big.NewInt(0))
+	require.Error(t, err)
+	_, err = NewSignerPool(newTestSigners("0x1", "0x1"), storage, nil, nil, 1, big.NewInt(0))
+	require.Error(t, err)
+
+	signers := newTestSigners("0x1", "0x2")
+	p, err := NewSignerPool(signers, storage, nil, nil, 1, big.NewInt(0))
+	require.NoError(t, err)
+	require.Equal(t, signers[0], p.Default())
+	require.Equal(t, []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, p.Addresses())
+	auth, err := p.Signer(common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	require.Equal(t, signers[1], auth)
+	_, err = p.Signer(common.HexToAddress("0x3"))
+	require.ErrorIs(t, err, errUnknownSigner)
+}
+
+func TestNewClaimTxManagerGroupingSigners(t *testing.T) {
+	// The compressed claims are sent by a single signer
+	cfg := Config{GroupingClaims: ConfigGroupingClaims{Enabled: true}}
+	_, err := NewClaimTxManager(context.Background(), cfg, nil, nil, "", 1, common.Address{}, nil, nil, 1, nil, newTestSigners("0x1", "0x2"))
+	require.ErrorContains(t, err, "single signer")
+}
+
+func TestSelectSigner(t *testing.T) {
+	s := func(addr string, load, unminedNonces uint64, balance int64) signerState {
+		return signerState{auth: newTestSigners(addr)[0], load: load, unminedNonces: unminedNonces, balance: big.NewInt(balance)}
+	}
+	_, found := selectSigner(nil)
+	require.False(t, found)
+
+	// The signer with the fewest claims in progress
+	best, found := selectSigner([]signerState{s("0x1", 2, 0, 100), s("0x2", 1, 5, 1), s("0x3", 3, 0, 100)})
+	require.True(t, found)
+	require.Equal(t, common.HexToAddress("0x2"), best.auth.From)
+
+	// Then the one with the fewest nonces not mined
+	best, _ = selectSigner([]signerState{s("0x1", 1, 4, 100), s("0x2", 1, 2, 1), s("0x3", 1, 3, 100)})
+	require.Equal(t, common.HexToAddress("0x2"), best.auth.From)
+
+	// Then the one with the highest balance
+	best, _ = selectSigner([]signerState{s("0x1", 1, 2, 100), s("0x2", 1, 2, 300), s("0x3", 1, 2, 200)})
+	require.Equal(t, common.HexToAddress("0x2"), best.auth.From)
+
+	// Then the first one
+	best, _ = selectSigner([]signerState{s("0x1", 1, 2, 100), s("0x2", 1, 2, 100)})
+	require.Equal(t, common.HexToAddress("0x1"), best.auth.From)
+}
+
+func TestSignerPoolSelectSigner(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewSignerStorage(t)
+	client := mock_txcompressor.NewSignerClient(t)
+	nonceStorage := mock_txcompressor.NewNonceStorage(t)
+	nonceClient := mock_txcompressor.NewNonceClient(t)
+	signer1, signer2, signer3 := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	p, err := NewSignerPool(newTestSigners("0x1", "0x2", "0x3"), storage, client, NewNonceAllocator(nonceStorage, nonceClient, 1), 1, big.NewInt(10))
+	require.NoError(t, err)
+	statuses := []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}
+
+	// The signer 1 has the fewest claims but not enough balance and the signer 3 has nonces not mined
+	storage.EXPECT().GetClaimTxsCountBySigner(ctx, statuses, uint32(1), mock.Anything).Return(map[common.Address]uint64{signer2: 1, signer3: 1}, nil).Once()
+	client.EXPECT().BalanceAt(ctx, signer1, mock.Anything).Return(big.NewInt(9), nil).Once()
+	client.EXPECT().BalanceAt(ctx, signer2, mock.Anything).Return(big.NewInt(10), nil).Once()
+	client.EXPECT().BalanceAt(ctx, signer3, mock.Anything).Return(big.NewInt(100), nil).Once()
+	nonceStorage.EXPECT().GetNextNonce(ctx, uint32(1), signer2, mock.Anything).Return(uint64(5), nil).Once()
+	nonceClient.EXPECT().NonceAt(ctx, signer2, mock.Anything).Return(uint64(4), nil).Once()
+	nonceStorage.EXPECT().GetNextNonce(ctx, uint32(1), signer3, mock.Anything).Return(uint64(7), nil).Once()
+	nonceClient.EXPECT().NonceAt(ctx, signer3, mock.Anything).Return(uint64(4), nil).Once()
+	auth, err := p.SelectSigner(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, signer2, auth.From)
+
+	// No signer has enough balance
+	storage.EXPECT().GetClaimTxsCountBySigner(ctx, statuses, uint32(1), mock.Anything).Return(map[common.Address]uint64{}, nil).Once()
+	client.EXPECT().BalanceAt(ctx, mock.Anything, mock.Anything).Return(big.NewInt(0), nil).Times(3)
+	_, err = p.SelectSigner(ctx, nil)
+	require.ErrorIs(t, err, errNoSignerAvailable)
+
+	// A signer without reserved nonces
+	storage.EXPECT().GetClaimTxsCountBySigner(ctx, statuses, uint32(1), mock.Anything).Return(map[common.Address]uint64{signer1: 1, signer2: 1}, nil).Once()
+	client.EXPECT().BalanceAt(ctx, mock.Anything, mock.Anything).Return(big.NewInt(10), nil).Times(3)
+	nonceStorage.EXPECT().GetNextNonce(ctx, uint32(1), mock.Anything, mock.Anything).Return(uint64(0), gerror.ErrStorageNotFound).Times(3)
+	auth, err = p.SelectSigner(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, signer3, auth.From)
+
+	// A single signer is always selected
+	p, err = NewSignerPool(newTestSigners("0x1"), storage, client, nil, 1, big.NewInt(10))
+	require.NoError(t, err)
+	auth, err = p.SelectSigner(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, signer1, auth.From)
+}
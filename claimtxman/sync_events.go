@@ -0,0 +1,134 @@
+package claimtxman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/core/types"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+type SyncEventsStorage interface {
+	GetLastBlock(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.Block, error)
+	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestTrustedExitRoot(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+}
+
+type HeaderGetter interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// SyncEventsPoller feeds a claim tx manager with the events of the synchronizers when they run
+// in another process. It reads the synced state from the database and sends the new exit roots
+// and the synced signal of the L2 network like the synchronizers do.
+type SyncEventsPoller struct {
+	cfg             Config
+	storage         SyncEventsStorage
+	l2Node          HeaderGetter
+	l2NetworkID     uint32
+	chExitRootEvent chan *etherman.GlobalExitRoot
+	chSynced        chan uint32
+
+	synced        bool
+	lastL1GER     *etherman.GlobalExitRoot
+	lastTrustedID uint64
+}
+
+// NewSyncEventsPoller creates a new poller of the sync events of a L2 network.
+func NewSyncEventsPoller(cfg Config, storage interface{}, l2Node HeaderGetter, l2NetworkID uint32,
+	chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint32) *SyncEventsPoller {
+	return &SyncEventsPoller{
+		cfg:             cfg,
+		storage:         storage.(SyncEventsStorage),
+		l2Node:          l2Node,
+		l2NetworkID:     l2NetworkID,
+		chExitRootEvent: chExitRootEvent,
+		chSynced:        chSynced,
+	}
+}
+
+// Start polls the database until the context is done.
+func (p *SyncEventsPoller) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.FrequencyToPollSyncEvents.Duration)
+	defer ticker.Stop()
+	for {
+		if err := p.poll(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("networkID: %d, error polling the sync events: %v", p.l2NetworkID, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll sends the synced signal once the L2 network is synced and the exit roots stored since
+// the previous poll.
+func (p *SyncEventsPoller) poll(ctx context.Context) error {
+	if !p.synced {
+		synced, err := p.isL2Synced(ctx)
+		if err != nil || !synced {
+			return err
+		}
+		log.Infof("NetworkID %d synced by the synchronizer", p.l2NetworkID)
+		select {
+		case p.chSynced <- p.l2NetworkID:
+			p.synced = true
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	l1GER, err := p.storage.GetLatestL1SyncedExitRoot(ctx, nil)
+	if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+		return err
+	}
+	if err == nil && (p.lastL1GER == nil || p.lastL1GER.GlobalExitRoot != l1GER.GlobalExitRoot || p.lastL1GER.BlockID != l1GER.BlockID) {
+		if !p.sendExitRoot(ctx, l1GER) {
+			return ctx.Err()
+		}
+		p.lastL1GER = l1GER
+	}
+
+	trustedGER, err := p.storage.GetLatestTrustedExitRoot(ctx, p.l2NetworkID, nil)
+	if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+		return err
+	}
+	if err == nil && trustedGER.ID != p.lastTrustedID {
+		if !p.sendExitRoot(ctx, trustedGER) {
+			return ctx.Err()
+		}
+		p.lastTrustedID = trustedGER.ID
+	}
+	return nil
+}
+
+// isL2Synced checks whether the last block synced of the L2 network is close enough to the head.
+func (p *SyncEventsPoller) isL2Synced(ctx context.Context) (bool, error) {
+	lastBlock, err := p.storage.GetLastBlock(ctx, p.l2NetworkID, nil)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	header, err := p.l2Node.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	return lastBlock.BlockNumber+p.cfg.SyncedBlocksLag >= header.Number.Uint64(), nil
+}
+
+func (p *SyncEventsPoller) sendExitRoot(ctx context.Context, ger *etherman.GlobalExitRoot) bool {
+	select {
+	case p.chExitRootEvent <- ger:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,68 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/go-ethereum/core/types"
+	mock_txcompressor "github.com/fiwallets/zkevm-bridge-service/claimtxman/mocks"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncEventsPoller(t *testing.T) {
+	ctx := context.Background()
+	storage := mock_txcompressor.NewSyncEventsStorage(t)
+	l2Node := mock_txcompressor.NewHeaderGetter(t)
+	chExitRootEvent := make(chan *etherman.GlobalExitRoot, 2)
+	chSynced := make(chan uint32, 1)
+	p := NewSyncEventsPoller(Config{SyncedBlocksLag: 5}, storage, l2Node, 1, chExitRootEvent, chSynced)
+
+	// The L2 network is not synced yet
+	storage.EXPECT().GetLastBlock(mock.Anything, uint32(1), mock.Anything).Return(&etherman.Block{BlockNumber: 10}, nil).Once()
+	l2Node.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{Number: big.NewInt(20)}, nil).Once()
+	require.NoError(t, p.poll(ctx))
+	require.Empty(t, chSynced)
+	require.Empty(t, chExitRootEvent)
+
+	// The L2 network is synced within the lag, the exit roots are sent
+	l1GER := &etherman.GlobalExitRoot{BlockID: 3, GlobalExitRoot: common.HexToHash("0x01"), ExitRoots: []common.Hash{{}, {}}}
+	trustedGER := &etherman.GlobalExitRoot{ID: 7, NetworkID: 1, GlobalExitRoot: common.HexToHash("0x02"), ExitRoots: []common.Hash{{}, {}}}
+	storage.EXPECT().GetLastBlock(mock.Anything, uint32(1), mock.Anything).Return(&etherman.Block{BlockNumber: 16}, nil).Once()
+	l2Node.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(&types.Header{Number: big.NewInt(21)}, nil).Once()
+	storage.EXPECT().GetLatestL1SyncedExitRoot(mock.Anything, mock.Anything).Return(l1GER, nil).Once()
+	storage.EXPECT().GetLatestTrustedExitRoot(mock.Anything, uint32(1), mock.Anything).Return(trustedGER, nil).Once()
+	require.NoError(t, p.poll(ctx))
+	require.Equal(t, uint32(1), <-chSynced)
+	require.Equal(t, l1GER, <-chExitRootEvent)
+	require.Equal(t, trustedGER, <-chExitRootEvent)
+
+	// The exit roots already sent are not sent again
+	storage.EXPECT().GetLatestL1SyncedExitRoot(mock.Anything, mock.Anything).Return(l1GER, nil).Once()
+	storage.EXPECT().GetLatestTrustedExitRoot(mock.Anything, uint32(1), mock.Anything).Return(trustedGER, nil).Once()
+	require.NoError(t, p.poll(ctx))
+	require.Empty(t, chSynced)
+	require.Empty(t, chExitRootEvent)
+
+	// Only the new exit roots are sent
+	newL1GER := &etherman.GlobalExitRoot{BlockID: 4, GlobalExitRoot: common.HexToHash("0x03"), ExitRoots: []common.Hash{{}, {}}}
+	storage.EXPECT().GetLatestL1SyncedExitRoot(mock.Anything, mock.Anything).Return(newL1GER, nil).Once()
+	storage.EXPECT().GetLatestTrustedExitRoot(mock.Anything, uint32(1), mock.Anything).Return(nil, gerror.ErrStorageNotFound).Once()
+	require.NoError(t, p.poll(ctx))
+	require.Equal(t, newL1GER, <-chExitRootEvent)
+	require.Empty(t, chExitRootEvent)
+
+	// A blocked send doesn't block the poller once the context is done
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	p.lastTrustedID = 0
+	storage.EXPECT().GetLatestL1SyncedExitRoot(mock.Anything, mock.Anything).Return(newL1GER, nil).Once()
+	storage.EXPECT().GetLatestTrustedExitRoot(mock.Anything, uint32(1), mock.Anything).Return(trustedGER, nil).Once()
+	chExitRootEvent <- l1GER
+	chExitRootEvent <- l1GER
+	require.ErrorIs(t, p.poll(cancelCtx), context.Canceled)
+}
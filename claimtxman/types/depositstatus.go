@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"github.com/fiwallets/go-ethereum/common"
+)
+
+// DepositClaimStatus gathers everything stored about the claim of a deposit:
+// when it was deposited, whether its exit root is already part of an L1 global
+// exit root, the monitored tx (and group) created by the claim tx manager and
+// the claim itself once it has been synced. The deposits rejected by the claim
+// policies have the reason instead of the monitored tx.
+type DepositClaimStatus struct {
+	// DepositedAt is the time of the block that contains the deposit
+	DepositedAt time.Time
+
+	// IncludedInGER is true when the local exit root of the deposit has been
+	// included in a global exit root synced from L1
+	IncludedInGER bool
+
+	// MonitoredTx is the claim tx created by the claim tx manager (could be nil)
+	MonitoredTx *MonitoredTx
+
+	// Group is the group that compresses the monitored tx (could be nil)
+	Group *MonitoredTxGroupDBEntry
+
+	// ClaimTxHash is the hash of the synced claim tx (could be nil)
+	ClaimTxHash *common.Hash
+
+	// ClaimedAt is the time of the block that contains the claim (could be nil)
+	ClaimedAt *time.Time
+
+	// RejectedReason is why the claim tx manager doesn't claim the deposit (could be nil)
+	RejectedReason *string
+
+	// RejectedAt is the time of the latest rejection of the deposit (could be nil)
+	RejectedAt *time.Time
+}
@@ -28,6 +28,10 @@ const (
 	MonitoredTxStatusCompressing = MonitoredTxStatus("compressing")
 
 	MonitoredTxStatusClaiming = MonitoredTxStatus("claimiming")
+
+	// MonitoredTxStatusClaimedExternally means the deposit was found claimed on-chain
+	// by another tx before sending the claim tx
+	MonitoredTxStatusClaimedExternally = MonitoredTxStatus("claimed_externally")
 )
 
 type TxMonitorer interface {
@@ -62,6 +66,10 @@ type MonitoredTx struct {
 	// Nonce used to create the tx
 	Nonce uint64
 
+	// NonceReserved is true when the nonce was reserved for the tx. It is reserved when the tx is sent for
+	// the first time, so the claims get their nonces in the order they are sent
+	NonceReserved bool
+
 	// Value is a tx value
 	Value *big.Int
 
@@ -71,9 +79,15 @@ type MonitoredTx struct {
 	// Gas is a tx gas
 	Gas uint64
 
-	// GasPrice is the tx gas price
+	// GasPrice is the tx gas price of a legacy tx
 	GasPrice *big.Int
 
+	// GasTipCap is the max priority fee per gas of an EIP-1559 tx
+	GasTipCap *big.Int
+
+	// GasFeeCap is the max fee per gas of an EIP-1559 tx. The tx is a legacy one when it is nil
+	GasFeeCap *big.Int
+
 	// Status of this monitoring
 	Status MonitoredTxStatus
 
@@ -93,6 +107,16 @@ type MonitoredTx struct {
 
 	// GlobalExitRoot is the ger used to get the merkle proof
 	GlobalExitRoot common.Hash
+
+	// SentAt is the last date time a tx was sent to the network, nil if it was never sent
+	SentAt *time.Time
+
+	// Bumps is the number of times the pending tx was replaced by a tx with bumped fees
+	Bumps uint64
+
+	// Cost is the cost of the mined txs, set when the monitoring finishes. In a group it is
+	// the share of the tx of the group cost
+	Cost TxCost
 }
 
 // MonitoredTxGroupStatus represents the status of a monitored tx
@@ -138,6 +162,9 @@ type MonitoredTxGroupDBEntry struct {
 	NumRetries int32
 	// LastLog is a textual status of the last action that taken and it's relevant
 	LastLog string
+
+	// Cost is the cost of the mined claim txs of the group
+	Cost TxCost
 }
 
 func (m *MonitoredTxGroupDBEntry) AddPendingTx(txHash common.Hash) uint64 {
@@ -207,6 +234,13 @@ func (m *MonitoredTxGroup) AssignTxsGroupID() {
 	}
 }
 
+// AssignTxsCost splits the cost of the group among its txs
+func (m *MonitoredTxGroup) AssignTxsCost() {
+	for idx, cost := range m.DbEntry.Cost.Split(len(m.Txs)) {
+		m.Txs[idx].Cost = cost
+	}
+}
+
 type TxHistoryV2 struct {
 	Version  uint64
 	TxHashes []TxHashHistoryEntry
@@ -258,6 +292,27 @@ func (t *TxHistoryV2) AddPendingTx(txHash common.Hash) {
 	})
 }
 
+// AddReplacementTx adds a tx that replaces a pending one with the same nonce and bumped fees
+func (t *TxHistoryV2) AddReplacementTx(txHash, replacedTxHash common.Hash) {
+	t.TxHashes = append(t.TxHashes, TxHashHistoryEntry{
+		TxHash:         txHash,
+		ReceiptStatus:  nil,
+		CreatedAt:      time.Now(),
+		ReplacedTxHash: &replacedTxHash,
+	})
+}
+
+// NumReplacements returns the number of txs of the history that replaced a pending one
+func (t *TxHistoryV2) NumReplacements() int {
+	n := 0
+	for idx := range t.TxHashes {
+		if t.TxHashes[idx].ReplacedTxHash != nil {
+			n++
+		}
+	}
+	return n
+}
+
 const (
 	ReceiptStatusFailed     = 0
 	ReceiptStatusSuccessful = 1
@@ -270,6 +325,8 @@ type TxHashHistoryEntry struct {
 	ReceiptStatus *uint64
 	// CreatedAt date time it was created
 	CreatedAt time.Time
+	// ReplacedTxHash is the pending tx replaced by this one with the same nonce and bumped fees
+	ReplacedTxHash *common.Hash `json:",omitempty"`
 }
 
 func (t *TxHashHistoryEntry) IsPending() bool {
@@ -314,8 +371,20 @@ func (t *TxHashHistoryEntry) IsExhaustedTimeWaitingForReceipt(now time.Time, max
 	return now.Sub(t.CreatedAt) > maxTime
 }
 
-// Tx uses the current information to build a tx
+// Tx uses the current information to build a tx. It builds an EIP-1559 tx when the fee cap is
+// set and a legacy tx otherwise
 func (mTx MonitoredTx) Tx() *types.Transaction {
+	if mTx.GasFeeCap != nil {
+		return types.NewTx(&types.DynamicFeeTx{
+			To:        mTx.To,
+			Nonce:     mTx.Nonce,
+			Value:     mTx.Value,
+			Data:      mTx.Data,
+			Gas:       mTx.Gas,
+			GasTipCap: mTx.GasTipCap,
+			GasFeeCap: mTx.GasFeeCap,
+		})
+	}
 	tx := types.NewTx(&types.LegacyTx{
 		To:       mTx.To,
 		Nonce:    mTx.Nonce,
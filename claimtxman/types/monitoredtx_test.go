@@ -45,3 +45,25 @@ func TestHistoryHashSlice(t *testing.T) {
 	assert.Equal(t, txs[1].Hash(), common.BytesToHash(history[0]))
 	t.Log("TEST3: ", txs[1].Hash(), common.BytesToHash(history[0]))
 }
+
+func TestTxHistoryV2Replacements(t *testing.T) {
+	history := &TxHistoryV2{Version: 1}
+	tx1 := common.HexToHash("0x01")
+	tx2 := common.HexToHash("0x02")
+	tx3 := common.HexToHash("0x03")
+	history.AddPendingTx(tx1)
+	require.Equal(t, 0, history.NumReplacements())
+	history.AddReplacementTx(tx2, tx1)
+	history.AddReplacementTx(tx3, tx2)
+	require.Equal(t, 2, history.NumReplacements())
+	require.Equal(t, tx1, *history.TxHashes[1].ReplacedTxHash)
+
+	// The replacements are kept in the stored history
+	jsonStr, err := history.ToJson()
+	require.NoError(t, err)
+	decoded, err := NewTxHistoryV2FromJson(jsonStr)
+	require.NoError(t, err)
+	require.Nil(t, decoded.TxHashes[0].ReplacedTxHash)
+	require.Equal(t, tx2, *decoded.TxHashes[2].ReplacedTxHash)
+	require.Equal(t, 2, decoded.NumReplacements())
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/go-ethereum/core/types"
+)
+
+// TxCost is the cost of the mined txs sent to claim, both the successful and the reverted ones
+type TxCost struct {
+	// GasUsed is the gas used by the mined txs
+	GasUsed uint64
+
+	// EffectiveGasPrice is the average price paid per gas, nil if no tx was mined
+	EffectiveGasPrice *big.Int
+
+	// Fee is the L2 fee paid, the gas used by the effective gas price. nil if no tx was mined
+	Fee *big.Int
+}
+
+// IsEmpty returns whether no mined tx was added to the cost
+func (c TxCost) IsEmpty() bool {
+	return c.Fee == nil
+}
+
+// AddReceipt adds the gas used and the fee paid by a mined tx
+func (c *TxCost) AddReceipt(receipt *types.Receipt) {
+	fee := new(big.Int)
+	if receipt.EffectiveGasPrice != nil {
+		fee.Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	}
+	c.add(receipt.GasUsed, fee)
+}
+
+func (c *TxCost) add(gasUsed uint64, fee *big.Int) {
+	if c.Fee == nil {
+		c.Fee = new(big.Int)
+	}
+	c.GasUsed += gasUsed
+	c.Fee = new(big.Int).Add(c.Fee, fee)
+	c.EffectiveGasPrice = new(big.Int)
+	if c.GasUsed > 0 {
+		c.EffectiveGasPrice.Div(c.Fee, new(big.Int).SetUint64(c.GasUsed))
+	}
+}
+
+// Split splits the cost in n equal parts, the remainders are added to the first part. It is used
+// to assign the cost of a compressed claim tx to each claim of the group
+func (c TxCost) Split(n int) []TxCost {
+	if n <= 0 {
+		return nil
+	}
+	parts := make([]TxCost, n)
+	if c.IsEmpty() {
+		return parts
+	}
+	count := uint64(n)
+	fee, feeRemainder := new(big.Int).QuoRem(c.Fee, new(big.Int).SetUint64(count), new(big.Int))
+	for i := range parts {
+		gasUsed := c.GasUsed / count
+		partFee := fee
+		if i == 0 {
+			gasUsed += c.GasUsed % count
+			partFee = new(big.Int).Add(fee, feeRemainder)
+		}
+		parts[i].add(gasUsed, partFee)
+		// The price paid per gas is the same for every part
+		parts[i].EffectiveGasPrice = new(big.Int).Set(c.EffectiveGasPrice)
+	}
+	return parts
+}
+
+// ClaimCost is the cost of the claims of a day with the same token, destination network and signer
+type ClaimCost struct {
+	// Day is the day, in UTC, the claims were finished
+	Day time.Time
+
+	// OriginalNetwork is the original network of the claimed token
+	OriginalNetwork uint32
+
+	// OriginalAddress is the original address of the claimed token
+	OriginalAddress common.Address
+
+	// DestinationNetwork is the network where the deposits were claimed
+	DestinationNetwork uint32
+
+	// Signer is the address that sent the claims
+	Signer common.Address
+
+	// Claims is the number of claims
+	Claims uint64
+
+	// GasUsed is the gas used by the claims
+	GasUsed uint64
+
+	// Fee is the L2 fee paid by the claims
+	Fee *big.Int
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCostAddReceipt(t *testing.T) {
+	var cost TxCost
+	require.True(t, cost.IsEmpty())
+
+	cost.AddReceipt(&types.Receipt{GasUsed: 100, EffectiveGasPrice: big.NewInt(10)})
+	require.False(t, cost.IsEmpty())
+	require.Equal(t, uint64(100), cost.GasUsed)
+	require.Equal(t, big.NewInt(1000), cost.Fee)
+	require.Equal(t, big.NewInt(10), cost.EffectiveGasPrice)
+
+	// The effective gas price is the average of the receipts
+	cost.AddReceipt(&types.Receipt{GasUsed: 300, EffectiveGasPrice: big.NewInt(20)})
+	require.Equal(t, uint64(400), cost.GasUsed)
+	require.Equal(t, big.NewInt(7000), cost.Fee)
+	require.Equal(t, big.NewInt(17), cost.EffectiveGasPrice)
+}
+
+func TestTxCostSplit(t *testing.T) {
+	require.Nil(t, TxCost{}.Split(0))
+	require.Equal(t, []TxCost{{}, {}}, TxCost{}.Split(2))
+
+	cost := TxCost{GasUsed: 1001, EffectiveGasPrice: big.NewInt(3), Fee: big.NewInt(3003)}
+	parts := cost.Split(2)
+	require.Len(t, parts, 2)
+	// The remainders are added to the first part
+	require.Equal(t, uint64(501), parts[0].GasUsed)
+	require.Equal(t, big.NewInt(1502), parts[0].Fee)
+	require.Equal(t, uint64(500), parts[1].GasUsed)
+	require.Equal(t, big.NewInt(1501), parts[1].Fee)
+	for _, part := range parts {
+		require.Equal(t, big.NewInt(3), part.EffectiveGasPrice)
+	}
+}
+
+func TestMonitoredTxGroupAssignTxsCost(t *testing.T) {
+	group := NewMonitoredTxGroup(MonitoredTxGroupDBEntry{GroupID: 1}, []MonitoredTx{{DepositID: 1}, {DepositID: 2}, {DepositID: 3}})
+	group.DbEntry.Cost.AddReceipt(&types.Receipt{GasUsed: 300, EffectiveGasPrice: big.NewInt(2)})
+	group.AssignTxsCost()
+	for _, tx := range group.Txs {
+		require.Equal(t, uint64(100), tx.Cost.GasUsed)
+		require.Equal(t, big.NewInt(200), tx.Cost.Fee)
+	}
+}
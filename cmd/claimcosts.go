@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
+	"github.com/fiwallets/zkevm-bridge-service/config"
+	"github.com/fiwallets/zkevm-bridge-service/db"
+	"github.com/fiwallets/zkevm-bridge-service/server"
+	"github.com/jackc/pgx/v4"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+)
+
+type claimCostsStorage interface {
+	GetClaimCosts(ctx context.Context, from, to time.Time, dbTx pgx.Tx) ([]*ctmtypes.ClaimCost, error)
+}
+
+// claimCostsCmd prints the cost of the claims sent by the claim tx manager per day, token, destination
+// network and signer.
+func claimCostsCmd(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	from, to, err := server.ClaimCostsRange(ctx.String(flagFrom), ctx.String(flagTo), time.Now())
+	if err != nil {
+		return err
+	}
+	storage, err := db.NewStorage(c.SyncDB)
+	if err != nil {
+		return err
+	}
+	s, ok := storage.(claimCostsStorage)
+	if !ok {
+		return fmt.Errorf("the storage doesn't support the claim costs report")
+	}
+	costs, err := s.GetClaimCosts(ctx.Context, from, to, nil)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:gomnd
+	fmt.Fprintln(w, "DAY\tORIG_NET\tORIG_ADDR\tDEST_NET\tSIGNER\tCLAIMS\tGAS_USED\tFEE")
+	for _, cost := range costs {
+		fee := "0"
+		if cost.Fee != nil {
+			fee = cost.Fee.String()
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\t%d\t%d\t%s\n", cost.Day.UTC().Format("2006-01-02"), cost.OriginalNetwork,
+			cost.OriginalAddress.Hex(), cost.DestinationNetwork, cost.Signer.Hex(), cost.Claims, cost.GasUsed, fee)
+	}
+	return w.Flush()
+}
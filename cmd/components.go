@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// apiComponent serves the bridge API from the database
+	apiComponent = "api"
+	// syncComponent synchronizes the L1 and L2 networks into the database
+	syncComponent = "sync"
+	// claimTxManagerComponent sends the claim txs of the deposits ready to be claimed
+	claimTxManagerComponent = "claimtxman"
+)
+
+// components are the components run by the process.
+type components struct {
+	api            bool
+	sync           bool
+	claimTxManager bool
+}
+
+// parseComponents parses the names of the components to run.
+func parseComponents(names []string) (components, error) {
+	var c components
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case apiComponent:
+			c.api = true
+		case syncComponent:
+			c.sync = true
+		case claimTxManagerComponent:
+			c.claimTxManager = true
+		default:
+			return c, fmt.Errorf("unknown component %q, the valid ones are: %s, %s, %s", name, apiComponent, syncComponent, claimTxManagerComponent)
+		}
+	}
+	if !c.api && !c.sync && !c.claimTxManager {
+		return c, errors.New("no component to run")
+	}
+	return c, nil
+}
+
+// writers returns the components that write to the database and must run in a single process.
+func (c components) writers() []string {
+	var writers []string
+	if c.sync {
+		writers = append(writers, syncComponent)
+	}
+	if c.claimTxManager {
+		writers = append(writers, claimTxManagerComponent)
+	}
+	return writers
+}
+
+func (c components) String() string {
+	var names []string
+	if c.api {
+		names = append(names, apiComponent)
+	}
+	return strings.Join(append(names, c.writers()...), ",")
+}
@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	flagCfg     = "cfg"
-	flagNetwork = "network"
+	flagCfg        = "cfg"
+	flagNetwork    = "network"
+	flagComponents = "components"
 )
 
 const (
@@ -35,6 +36,13 @@ func main() {
 			Usage:    "Network: mainnet, testnet, internaltestnet, local. By default it uses mainnet",
 			Required: false,
 		},
+		&cli.StringSliceFlag{
+			Name:     flagComponents,
+			Aliases:  []string{"co"},
+			Usage:    "Comma separated list of components to run: api, sync, claimtxman. The API can be scaled horizontally, the sync and claimtxman components run in a single process",
+			Required: false,
+			Value:    cli.NewStringSlice(apiComponent, syncComponent, claimTxManagerComponent),
+		},
 	}
 
 	app.Commands = []*cli.Command{
@@ -51,6 +59,26 @@ func main() {
 			Action:  start,
 			Flags:   flags,
 		},
+		{
+			Name:    "claim-costs",
+			Aliases: []string{},
+			Usage:   "Print the cost of the claims sent by the claim tx manager per day, token, destination network and signer",
+			Action:  claimCostsCmd,
+			Flags: []cli.Flag{
+				flags[0],
+				flags[1],
+				&cli.StringFlag{
+					Name:     flagFrom,
+					Usage:    "First `DAY` of the report, YYYY-MM-DD in UTC. By default it is 29 days before the last day",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     flagTo,
+					Usage:    "Last `DAY` of the report, YYYY-MM-DD in UTC. By default it is today",
+					Required: false,
+				},
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"os/signal"
+	"net"
+	"net/http"
 	"runtime"
+	"time"
 
 	zkevmbridgeservice "github.com/fiwallets/zkevm-bridge-service"
 	"github.com/fiwallets/zkevm-bridge-service/bridgectrl"
@@ -14,11 +16,16 @@ import (
 	"github.com/fiwallets/zkevm-bridge-service/db"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/remotesigner"
 	"github.com/fiwallets/zkevm-bridge-service/server"
+	"github.com/fiwallets/zkevm-bridge-service/shutdown"
 	"github.com/fiwallets/zkevm-bridge-service/synchronizer"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
 	"github.com/urfave/cli/v2"
 )
 
@@ -43,26 +50,35 @@ func start(ctx *cli.Context) error {
 	}
 	setupLog(c.Log)
 	logVersion()
-	err = db.RunMigrations(c.SyncDB)
+	components, err := parseComponents(ctx.StringSlice(flagComponents))
 	if err != nil {
 		log.Error(err)
 		return err
 	}
-
-	l1Etherman, l2Ethermans, err := newEthermans(c)
+	if components.claimTxManager && !c.ClaimTxManager.Enabled {
+		log.Warn("ClaimTxManager component selected but not enabled in the config, it won't be started")
+		components.claimTxManager = false
+	}
+	log.Infof("running components: %s", components)
+	if c.Metrics.Enabled {
+		go startMetricsHTTPServer(c.Metrics)
+	}
+	shutdownTracing, err := tracing.Init(ctx.Context, c.Tracing)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
-
-	networkID := l1Etherman.GetNetworkID()
-	log.Infof("main network id: %d", networkID)
-
-	var networkIDs = []uint32{networkID}
-	for _, client := range l2Ethermans {
-		networkID := client.GetNetworkID()
-		log.Infof("l2 network id: %d", networkID)
-		networkIDs = append(networkIDs, networkID)
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Errorf("error flushing the pending spans: %v", err)
+		}
+	}()
+	coordinator := shutdown.NewCoordinator(ctx.Context, c.Shutdown)
+	appCtx := coordinator.Context()
+	err = db.RunMigrations(c.SyncDB)
+	if err != nil {
+		log.Error(err)
+		return err
 	}
 
 	storage, err := db.NewStorage(c.SyncDB)
@@ -71,17 +87,78 @@ func start(ctx *cli.Context) error {
 		return err
 	}
 
-	var bridgeController *bridgectrl.BridgeController
+	// The writers of the synced state and the claim txs must be unique
+	for _, writer := range components.writers() {
+		lock, err := db.AcquireWriterLock(ctx.Context, storage, writer)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		defer func(writer string) {
+			if err := lock.Release(context.Background()); err != nil {
+				log.Errorf("error releasing the %s writer lock: %v", writer, err)
+			}
+		}(writer)
+		go func(writer string) {
+			select {
+			case <-lock.Lost():
+				log.Fatalf("the %s writer lock is lost, another instance could be writing to the database", writer)
+			case <-appCtx.Done():
+			}
+		}(writer)
+	}
 
-	if c.BridgeController.Store == "postgres" {
-		bridgeController, err = bridgectrl.NewBridgeController(ctx.Context, c.BridgeController, networkIDs, storage)
+	// The API is served from the DB, so the RPCs are only dialed by the sync and claimtxman components
+	var (
+		l1Etherman  *etherman.Client
+		l2Ethermans []*etherman.Client
+		networkIDs  []uint32
+	)
+	if components.sync || components.claimTxManager {
+		l1Etherman, l2Ethermans, err = newEthermans(c)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
+		networkID := l1Etherman.GetNetworkID()
+		log.Infof("main network id: %d", networkID)
+		networkIDs = []uint32{networkID}
+		for i, client := range l2Ethermans {
+			networkID := client.GetNetworkID()
+			log.Infof("l2 network id: %d", networkID)
+			if len(c.NetworkConfig.L2NetworkIDs) > 0 && c.NetworkConfig.L2NetworkIDs[i] != networkID {
+				err := fmt.Errorf("the configured L2NetworkIDs[%d] %d doesn't match the network id %d of the L2 bridge", i, c.NetworkConfig.L2NetworkIDs[i], networkID)
+				log.Error(err)
+				return err
+			}
+			networkIDs = append(networkIDs, networkID)
+		}
 	} else {
-		log.Error(gerror.ErrStorageNotRegister)
-		return gerror.ErrStorageNotRegister
+		if len(c.NetworkConfig.L2NetworkIDs) == 0 {
+			err := errors.New("the L2NetworkIDs must be configured to run the API without the sync and claimtxman components")
+			log.Error(err)
+			return err
+		}
+		networkIDs = append([]uint32{0}, c.NetworkConfig.L2NetworkIDs...)
+		log.Infof("configured network ids: %v", networkIDs)
+	}
+
+	var (
+		syncStatusTracker *synchronizer.StatusTracker
+		bridgeController  *bridgectrl.BridgeController
+	)
+	if components.sync {
+		if c.BridgeController.Store == "postgres" {
+			bridgeController, err = bridgectrl.NewBridgeController(ctx.Context, c.BridgeController, networkIDs, storage)
+			if err != nil {
+				log.Error(err)
+				return err
+			}
+		} else {
+			log.Error(gerror.ErrStorageNotRegister)
+			return gerror.ErrStorageNotRegister
+		}
+		syncStatusTracker = synchronizer.NewStatusTracker()
 	}
 
 	apiStorage, err := db.NewStorage(c.BridgeServer.DB)
@@ -90,73 +167,133 @@ func start(ctx *cli.Context) error {
 		return err
 	}
 	bridgeService := server.NewBridgeService(c.BridgeServer, c.BridgeController.Height, networkIDs, apiStorage)
-	err = server.RunServer(c.BridgeServer, bridgeService)
-	if err != nil {
-		log.Error(err)
-		return err
+	var healthChecker *server.HealthChecker
+	if components.api {
+		if components.sync {
+			bridgeService.SetSyncStatusTracker(syncStatusTracker)
+			healthChecker = server.NewHealthChecker(c.BridgeServer.Health, networkIDs, apiStorage, syncStatusTracker)
+		} else {
+			healthChecker = server.NewHealthChecker(c.BridgeServer.Health, networkIDs, apiStorage, nil)
+		}
+		if l1Etherman != nil {
+			bridgeService.RegisterNetwork(networkIDs[0], c.NetworkConfig.PolygonBridgeAddress, l1Etherman.EtherClient)
+			for i, l2EthermanClient := range l2Ethermans {
+				bridgeService.RegisterNetwork(networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], l2EthermanClient.EtherClient)
+			}
+		} else {
+			// Without the RPC clients the claim gas is not estimated
+			bridgeService.RegisterNetwork(networkIDs[0], c.NetworkConfig.PolygonBridgeAddress, nil)
+			for i, addr := range c.NetworkConfig.L2PolygonBridgeAddresses {
+				bridgeService.RegisterNetwork(networkIDs[i+1], addr, nil)
+			}
+		}
+		apiServer, err := server.RunServer(c.BridgeServer, bridgeService, healthChecker)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		coordinator.Register(shutdown.StageIntake, "API server", apiServer.Stop)
 	}
 
 	var chsExitRootEvent []chan *etherman.GlobalExitRoot
 	var chsSyncedL2 []chan uint32
-	for i, l2EthermanClient := range l2Ethermans {
-		log.Debug("trusted sequencer URL ", c.Etherman.L2URLs[i])
-		zkEVMClient := client.NewClient(c.Etherman.L2URLs[i])
-		chExitRootEventL2 := make(chan *etherman.GlobalExitRoot)
-		chSyncedL2 := make(chan uint32)
-		chsExitRootEvent = append(chsExitRootEvent, chExitRootEventL2)
-		chsSyncedL2 = append(chsSyncedL2, chSyncedL2)
-		go runSynchronizer(ctx.Context, 0, bridgeController, l2EthermanClient, c.Synchronizer, storage, zkEVMClient, chExitRootEventL2, nil, chSyncedL2, []uint32{}, c.NetworkConfig.SovereignChains[i])
+	for i := range l2Ethermans {
+		chsExitRootEvent = append(chsExitRootEvent, make(chan *etherman.GlobalExitRoot))
+		chsSyncedL2 = append(chsSyncedL2, make(chan uint32))
+		if !components.sync && components.claimTxManager {
+			// The synchronizers run in another process, the events are read from the DB
+			runSyncEventsPoller(coordinator, c.ClaimTxManager, storage, l2Ethermans[i], networkIDs[i+1], chsExitRootEvent[i], chsSyncedL2[i])
+		}
 	}
-	chSynced := make(chan uint32)
-	go runSynchronizer(ctx.Context, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, nil, nil, chsExitRootEvent, chSynced, networkIDs, false)
-	go func() {
-		for {
-			select {
-			case netID := <-chSynced:
-				log.Debug("NetworkID synced: ", netID)
-			case <-ctx.Done():
-				log.Debug("Stopping goroutine that listen new GER updates")
-				return
+	if components.sync {
+		for i, l2EthermanClient := range l2Ethermans {
+			log.Debug("trusted sequencer URL ", c.Etherman.L2URLs[i])
+			zkEVMClient := client.NewClient(c.Etherman.L2URLs[i])
+			runSynchronizer(coordinator, 0, bridgeController, l2EthermanClient, c.Synchronizer, storage, zkEVMClient, chsExitRootEvent[i], nil, chsSyncedL2[i], []uint32{}, c.NetworkConfig.SovereignChains[i], syncStatusTracker)
+		}
+		chSynced := make(chan uint32)
+		runSynchronizer(coordinator, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, nil, nil, chsExitRootEvent, chSynced, networkIDs, false, syncStatusTracker)
+		go func() {
+			for {
+				select {
+				case netID := <-chSynced:
+					log.Debug("NetworkID synced: ", netID)
+				case <-appCtx.Done():
+					log.Debug("Stopping goroutine that listen new GER updates")
+					return
+				}
 			}
+		}()
+	}
+	if components.claimTxManager {
+		if len(c.ClaimTxManager.PrivateKey) == 0 && len(c.ClaimTxManager.RemoteSigners) == 0 {
+			log.Fatal("the claim tx manager requires at least one signer, configure ClaimTxManager.PrivateKey or ClaimTxManager.RemoteSigners")
 		}
-	}()
-	if c.ClaimTxManager.Enabled {
 		for i := 0; i < len(c.Etherman.L2URLs); i++ {
 			// we should match the orders of L2URLs between etherman and claimtxman
 			// since we are using the networkIDs in the same order
-			ctx := context.Background()
+			ctx := appCtx
 			client, err := utils.NewClient(ctx, c.Etherman.L2URLs[i], c.NetworkConfig.L2PolygonBridgeAddresses[i])
 			if err != nil {
 				log.Fatalf("error creating client for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
 			}
-			nonceCache, err := claimtxman.NewNonceCache(ctx, client)
-			if err != nil {
-				log.Fatalf("error creating nonceCache for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
+			signers := make([]*bind.TransactOpts, 0, len(c.ClaimTxManager.PrivateKey)+len(c.ClaimTxManager.RemoteSigners))
+			for _, ks := range c.ClaimTxManager.PrivateKey {
+				auth, err := client.GetSignerFromKeystore(ctx, ks)
+				if err != nil {
+					log.Fatalf("error creating signer %s for L2 %s. Error: %v", ks.Path, c.Etherman.L2URLs[i], err)
+				}
+				signers = append(signers, auth)
 			}
-			auth, err := client.GetSignerFromKeystore(ctx, c.ClaimTxManager.PrivateKey)
-			if err != nil {
-				log.Fatalf("error creating signer for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
+			if len(c.ClaimTxManager.RemoteSigners) > 0 {
+				chainID, err := client.ChainID(ctx)
+				if err != nil {
+					log.Fatalf("error getting the chainID of L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
+				}
+				for _, rs := range c.ClaimTxManager.RemoteSigners {
+					auth, err := remotesigner.NewTransactOpts(ctx, rs, chainID)
+					if err != nil {
+						log.Fatalf("error creating remote signer %s for L2 %s. Error: %v", rs.Address.String(), c.Etherman.L2URLs[i], err)
+					}
+					signers = append(signers, auth)
+				}
 			}
 			rollupID := l2Ethermans[i].GetNetworkID() // RollupID == networkID
 			claimTxManager, err := claimtxman.NewClaimTxManager(ctx, c.ClaimTxManager, chsExitRootEvent[i], chsSyncedL2[i],
-				c.Etherman.L2URLs[i], networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], bridgeService, storage, rollupID, l2Ethermans[i], nonceCache, auth)
+				c.Etherman.L2URLs[i], networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], bridgeService, storage, rollupID, l2Ethermans[i], signers)
 			if err != nil {
 				log.Fatalf("error creating claim tx manager for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
 			}
-			go claimTxManager.Start()
+			if healthChecker != nil {
+				healthChecker.RegisterClaimTxManager(rollupID, claimTxManager)
+			}
+			done := make(chan struct{})
+			coordinator.Register(shutdown.StageClaimTxManager, fmt.Sprintf("claim tx manager %d", rollupID), shutdown.StopAndWait(claimTxManager.Stop, done))
+			go func() {
+				defer close(done)
+				claimTxManager.Start()
+			}()
 		}
-	} else {
-		log.Warn("ClaimTxManager not configured")
-		for i := range chsExitRootEvent {
-			monitorChannel(ctx.Context, chsExitRootEvent[i], chsSyncedL2[i], networkIDs[i+1], storage)
+	} else if components.sync {
+		if c.ClaimTxManager.Enabled {
+			// The claim tx managers run in another process and update the deposits status
+			log.Info("ClaimTxManager running in another process")
+			for i := range chsExitRootEvent {
+				drainChannels(appCtx, chsExitRootEvent[i], chsSyncedL2[i])
+			}
+		} else {
+			log.Warn("ClaimTxManager not configured")
+			for i := range chsExitRootEvent {
+				monitorChannel(appCtx, chsExitRootEvent[i], chsSyncedL2[i], networkIDs[i+1], storage)
+			}
 		}
 	}
 
-	// Wait for an in interrupt.
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-	<-ch
-
+	// Wait for an interrupt or a termination signal and stop the components
+	if err := coordinator.Wait(); err != nil {
+		log.Error(err)
+		return err
+	}
 	return nil
 }
 
@@ -164,6 +301,30 @@ func setupLog(c log.Config) {
 	log.Init(c)
 }
 
+func startMetricsHTTPServer(c metrics.Config) {
+	mux := http.NewServeMux()
+	address := fmt.Sprintf("%s:%d", c.Host, c.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("failed to create tcp listener for metrics: %v", err)
+		return
+	}
+	mux.Handle(metrics.Endpoint, metrics.Handler())
+
+	metricsServer := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second, //nolint:gomnd
+	}
+	log.Infof("metrics server listening on port %d", c.Port)
+	if err := metricsServer.Serve(lis); err != nil {
+		if err == http.ErrServerClosed {
+			log.Warnf("http server for metrics stopped")
+			return
+		}
+		log.Errorf("closed http connection for metrics server: %v", err)
+	}
+}
+
 func monitorChannel(ctx context.Context, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint32, networkID uint32, storage db.Storage) {
 	go func() {
 		for {
@@ -221,6 +382,23 @@ func monitorChannel(ctx context.Context, chExitRootEvent chan *etherman.GlobalEx
 		}
 	}()
 }
+
+// drainChannels discards the events of the synchronizers of a network when the claim tx manager
+// runs in another process.
+func drainChannels(ctx context.Context, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint32) {
+	go func() {
+		for {
+			select {
+			case <-chExitRootEvent:
+			case netID := <-chSynced:
+				log.Debug("NetworkID synced: ", netID)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
 func newEthermans(c *config.Config) (*etherman.Client, []*etherman.Client, error) {
 	l1Etherman, err := etherman.NewClient(c.Etherman,
 		c.NetworkConfig.PolygonBridgeAddress,
@@ -245,12 +423,32 @@ func newEthermans(c *config.Config) (*etherman.Client, []*etherman.Client, error
 	return l1Etherman, l2Ethermans, nil
 }
 
-func runSynchronizer(ctx context.Context, genBlockNumber uint64, brdigeCtrl *bridgectrl.BridgeController, etherman *etherman.Client, cfg synchronizer.Config, storage db.Storage, zkEVMClient *client.Client, chExitRootEventL2 chan *etherman.GlobalExitRoot, chsExitRootEvent []chan *etherman.GlobalExitRoot, chSynced chan uint32, allNetworkIDs []uint32, sovereignChain bool) {
-	sy, err := synchronizer.NewSynchronizer(ctx, storage, brdigeCtrl, etherman, zkEVMClient, genBlockNumber, chExitRootEventL2, chsExitRootEvent, chSynced, cfg, allNetworkIDs, sovereignChain)
+// runSynchronizer starts the synchronizer of a network in the background. It's stopped by the
+// shutdown coordinator once it finishes the block being processed.
+func runSynchronizer(coordinator *shutdown.Coordinator, genBlockNumber uint64, brdigeCtrl *bridgectrl.BridgeController, etherman *etherman.Client, cfg synchronizer.Config, storage db.Storage, zkEVMClient *client.Client, chExitRootEventL2 chan *etherman.GlobalExitRoot, chsExitRootEvent []chan *etherman.GlobalExitRoot, chSynced chan uint32, allNetworkIDs []uint32, sovereignChain bool, statusTracker *synchronizer.StatusTracker) {
+	sy, err := synchronizer.NewSynchronizer(coordinator.Context(), storage, brdigeCtrl, etherman, zkEVMClient, genBlockNumber, chExitRootEventL2, chsExitRootEvent, chSynced, cfg, allNetworkIDs, sovereignChain, statusTracker)
 	if err != nil {
 		log.Fatal(err)
 	}
-	if err := sy.Sync(); err != nil {
-		log.Fatal(err)
-	}
+	done := make(chan struct{})
+	coordinator.Register(shutdown.StageSync, fmt.Sprintf("synchronizer %d", etherman.GetNetworkID()), shutdown.StopAndWait(sy.Stop, done))
+	go func() {
+		defer close(done)
+		if err := sy.Sync(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+}
+
+// runSyncEventsPoller feeds a claim tx manager with the events of the synchronizers running in
+// another process. It's stopped with the synchronizers by the shutdown coordinator.
+func runSyncEventsPoller(coordinator *shutdown.Coordinator, cfg claimtxman.Config, storage db.Storage, l2Etherman *etherman.Client, l2NetworkID uint32, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint32) {
+	poller := claimtxman.NewSyncEventsPoller(cfg, storage, l2Etherman, l2NetworkID, chExitRootEvent, chSynced)
+	ctx, cancel := context.WithCancel(coordinator.Context())
+	done := make(chan struct{})
+	coordinator.Register(shutdown.StageSync, fmt.Sprintf("sync events poller %d", l2NetworkID), shutdown.StopAndWait(cancel, done))
+	go func() {
+		defer close(done)
+		poller.Start(ctx)
+	}()
 }
@@ -10,8 +10,11 @@ import (
 	"github.com/fiwallets/zkevm-bridge-service/db"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
 	"github.com/fiwallets/zkevm-bridge-service/server"
+	"github.com/fiwallets/zkevm-bridge-service/shutdown"
 	"github.com/fiwallets/zkevm-bridge-service/synchronizer"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -25,6 +28,9 @@ type Config struct {
 	Synchronizer     synchronizer.Config
 	BridgeController bridgectrl.Config
 	BridgeServer     server.Config
+	Metrics          metrics.Config
+	Tracing          tracing.Config
+	Shutdown         shutdown.Config
 	NetworkConfig
 }
 
@@ -83,6 +89,9 @@ func Load(configFilePath string, network string) (*Config, error) {
 		len(cfg.L2PolygonBridgeAddresses) != len(cfg.Etherman.L2URLs) {
 		return nil, errors.New("the number of sovereign chains, L2PolygonZkEVMGlobalExitRootAddresses, L2PolygonBridgeAddresses and L2URLs must be the same")
 	}
+	if len(cfg.L2NetworkIDs) != 0 && len(cfg.L2NetworkIDs) != len(cfg.L2PolygonBridgeAddresses) {
+		return nil, errors.New("the number of L2NetworkIDs and L2PolygonBridgeAddresses must be the same")
+	}
 
 	return cfg, nil
 }
@@ -25,11 +25,15 @@ MaxConns = 20
 [ClaimTxManager]
 Enabled = false
 FrequencyToMonitorTxs = "1s"
-PrivateKey = {Path = "./test/test.keystore", Password = "testonly"}
+PrivateKey = []
+MinSignerBalance = 0
+RemoteSigners = []
 RetryInterval = "1s"
 RetryNumber = 10
 AuthorizedClaimMessageAddresses = []
 AreClaimsBetweenL2sEnabled = false
+FrequencyToPollSyncEvents = "1s"
+SyncedBlocksLag = 10
 [ClaimTxManager.GroupingClaims]
     Enabled = false
     FrequencyToProcessCompressedClaims = "10m"
@@ -40,6 +44,44 @@ AreClaimsBetweenL2sEnabled = false
     RetryInterval = "10s"
     RetryTimeout = "30s"
     GasOffset = 0
+[ClaimTxManager.TxFees]
+    LegacyNetworks = []
+    MaxGasPrice = 0
+    MaxClaimCost = 0
+    TipCapStrategy = "suggested"
+    TipCapMultiplier = 1
+    FixedTipCap = 0
+    FeeCapStrategy = "basefee"
+    BaseFeeMultiplier = 2
+    FixedFeeCap = 0
+[ClaimTxManager.TxFees.GasPricer]
+    Type = "multiplier"
+    FixedGasPrice = 0
+    Multiplier = 10
+    Blocks = 20
+    Percentile = 60
+    OracleURL = ""
+    OracleTimeout = "5s"
+[ClaimTxManager.TxReplacement]
+    Enabled = true
+    Timeout = "20s"
+    FeeBumpPercentage = 10
+    MaxBumps = 5
+[ClaimTxManager.Policies]
+    Tokens = []
+    OnlyListedTokens = false
+    AllowedDestinationAddresses = []
+    DeniedDestinationAddresses = []
+    AllowedMessageContracts = []
+    DailyBudgetPerDestination = 0
+[ClaimTxManager.Priority]
+    AgeWeight = 1
+    AmountWeight = 0
+    Tokens = []
+    VIPAddresses = []
+    VIPBonus = 0
+    MessageBonus = 0
+    MaxWaitingTime = "1h"
 
 
 [Etherman]
@@ -61,6 +103,7 @@ DefaultPageLimit = 25
 CacheSize = 100000
 MaxPageLimit = 100
 BridgeVersion = "v1"
+EnableReflection = false
     [BridgeServer.DB]
     Database = "postgres"
     User = "test_user"
@@ -69,6 +112,57 @@ BridgeVersion = "v1"
     Host = "zkevm-bridge-db"
     Port = "5432"
     MaxConns = 20
+    [BridgeServer.Health]
+    CheckInterval = "10s"
+    MaxSyncLag = 100
+    MaxSyncStatusAge = "5m"
+    MaxClaimTxManagerInactivity = "5m"
+    [BridgeServer.TLS]
+    Enabled = false
+    CertFile = ""
+    KeyFile = ""
+    ClientCAFile = ""
+    GatewayCAFile = ""
+    GatewayServerName = ""
+    GatewayCertFile = ""
+    GatewayKeyFile = ""
+    [BridgeServer.Auth]
+    Enabled = false
+    APIKeys = []
+    [BridgeServer.RateLimit]
+    Enabled = false
+    ExpensiveMethods = ["GetProof", "GetProofs", "GetProofByGER", "GetPendingBridgesToClaim", "GetClaimTxData", "VerifyClaimProof"]
+        [BridgeServer.RateLimit.Default]
+        RequestsPerSecond = 20.0
+        Burst = 40
+        [BridgeServer.RateLimit.Expensive]
+        RequestsPerSecond = 2.0
+        Burst = 5
+    [BridgeServer.CORS]
+    AllowedOrigins = []
+    AllowedMethods = ["GET", "HEAD", "POST", "PUT", "DELETE"]
+    AllowedHeaders = ["Content-Type", "Accept", "Authorization", "X-API-Key"]
+    AllowCredentials = false
+    MaxAge = "10m"
+    [BridgeServer.OpenAPI]
+    Enabled = false
+    SpecPath = "/openapi.json"
+    UIPath = "/swagger-ui"
+
+[Metrics]
+Enabled = false
+Host = "0.0.0.0"
+Port = 9091
+
+[Tracing]
+Enabled = false
+Endpoint = "localhost:4317"
+Insecure = true
+ServiceName = "zkevm-bridge-service"
+SampleRatio = 1.0
+
+[Shutdown]
+Timeout = "30s"
 `
 
 // Default parses the default configuration values.
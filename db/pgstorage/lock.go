@@ -0,0 +1,97 @@
+package pgstorage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const lockCheckInterval = 5 * time.Second
+
+// AdvisoryLock is a session level advisory lock held on a dedicated connection of the pool.
+// It's used to make sure that a single process writes the state of a component.
+type AdvisoryLock struct {
+	name string
+	conn *pgxpool.Conn
+
+	lost     chan struct{}
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// TryAdvisoryLock takes the advisory lock identified by the name. It returns
+// gerror.ErrLockNotAvailable if the lock is held by another session. The connection is checked
+// periodically and the Lost channel is closed if the lock can't be guaranteed anymore.
+func (p *PostgresStorage) TryAdvisoryLock(ctx context.Context, name string) (*AdvisoryLock, error) {
+	conn, err := p.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	const tryLockSQL = "SELECT pg_try_advisory_lock(hashtext($1))"
+	var locked bool
+	if err := conn.QueryRow(ctx, tryLockSQL, name).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, err
+	}
+	if !locked {
+		conn.Release()
+		return nil, gerror.ErrLockNotAvailable
+	}
+	l := &AdvisoryLock{
+		name:   name,
+		conn:   conn,
+		lost:   make(chan struct{}),
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go l.check()
+	return l, nil
+}
+
+// check pings the connection holding the lock. The lock is released by the database when the
+// session ends, so it's considered lost when the connection is broken.
+func (l *AdvisoryLock) check() {
+	defer close(l.done)
+	ticker := time.NewTicker(lockCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stopCh:
+			return
+		case <-ticker.C:
+			err := l.conn.Conn().Ping(context.Background())
+			if err != nil {
+				log.Errorf("advisory lock %s lost: %v", l.name, err)
+				close(l.lost)
+				return
+			}
+		}
+	}
+}
+
+// Lost returns a channel that is closed when the lock is lost.
+func (l *AdvisoryLock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release releases the lock and returns the connection to the pool.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	var err error
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+		<-l.done
+		const unlockSQL = "SELECT pg_advisory_unlock(hashtext($1))"
+		_, err = l.conn.Exec(ctx, unlockSQL, l.name)
+		if err != nil {
+			// Closing the session releases the lock
+			_ = l.conn.Conn().Close(ctx)
+		}
+		l.conn.Release()
+	})
+	return err
+}
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+CREATE INDEX IF NOT EXISTS token_wrapped_wrapped_token_addr_idx ON sync.token_wrapped USING btree (wrapped_token_addr, network_id);
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS sync.token_wrapped_wrapped_token_addr_idx;
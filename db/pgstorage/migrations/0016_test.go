@@ -0,0 +1,47 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0016 struct{}
+
+const getIndex0016 = `SELECT count(*) FROM pg_indexes WHERE indexname = 'token_wrapped_wrapped_token_addr_idx';`
+
+func (m migrationTest0016) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(69, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 1, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	const tokenSQL = `INSERT INTO sync.token_wrapped
+		(network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals)
+		VALUES(1, 0, decode('0000000000000000000000000000000000000001','hex'), decode('0000000000000000000000000000000000000002','hex'), 69, 'Token', 'TKN', 18);`
+	if _, err := db.Exec(tokenSQL); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0016) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var result int
+	assert.NoError(t, db.QueryRow(getIndex0016).Scan(&result))
+	assert.Equal(t, 1, result)
+
+	const getTokenSQL = `SELECT symbol FROM sync.token_wrapped WHERE wrapped_token_addr = decode('0000000000000000000000000000000000000002','hex') AND network_id = 1;`
+	var symbol string
+	assert.NoError(t, db.QueryRow(getTokenSQL).Scan(&symbol))
+	assert.Equal(t, "TKN", symbol)
+}
+
+func (m migrationTest0016) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var result int
+	assert.NoError(t, db.QueryRow(getIndex0016).Scan(&result))
+	assert.Equal(t, 0, result)
+}
+
+func TestMigration0016(t *testing.T) {
+	runMigrationTest(t, 16, migrationTest0016{})
+}
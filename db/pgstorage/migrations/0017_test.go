@@ -0,0 +1,51 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0017 struct{}
+
+const getFees0017 = `SELECT gas_price, gas_tip_cap, gas_fee_cap FROM sync.monitored_txs WHERE deposit_id = $1;`
+
+func (m migrationTest0017) InsertData(db *sql.DB) error {
+	const txSQL = `INSERT INTO sync.monitored_txs
+		(deposit_id, from_addr, to_addr, nonce, value, "data", gas, status, history, created_at, updated_at)
+		VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('FE12ABAA190EF0C8638EE0BA9F828BF41368CA0E','hex'), 9, '0', decode('CCAA2D11','hex'), 200000, 'created', '{}', '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491');`
+	if _, err := db.Exec(txSQL); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0017) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	// The fees of the existing txs are unknown
+	var gasPrice, gasTipCap, gasFeeCap sql.NullString
+	assert.NoError(t, db.QueryRow(getFees0017, 1).Scan(&gasPrice, &gasTipCap, &gasFeeCap))
+	assert.False(t, gasPrice.Valid)
+	assert.False(t, gasTipCap.Valid)
+	assert.False(t, gasFeeCap.Valid)
+
+	const updateSQL = `UPDATE sync.monitored_txs SET gas_tip_cap = '1000000000', gas_fee_cap = '30000000000' WHERE deposit_id = 1;`
+	_, err := db.Exec(updateSQL)
+	assert.NoError(t, err)
+	assert.NoError(t, db.QueryRow(getFees0017, 1).Scan(&gasPrice, &gasTipCap, &gasFeeCap))
+	assert.False(t, gasPrice.Valid)
+	assert.Equal(t, "1000000000", gasTipCap.String)
+	assert.Equal(t, "30000000000", gasFeeCap.String)
+}
+
+func (m migrationTest0017) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var gasFeeCap sql.NullString
+	assert.Error(t, db.QueryRow(`SELECT gas_fee_cap FROM sync.monitored_txs WHERE deposit_id = 1;`).Scan(&gasFeeCap))
+	var status string
+	assert.NoError(t, db.QueryRow(`SELECT status FROM sync.monitored_txs WHERE deposit_id = 1;`).Scan(&status))
+	assert.Equal(t, "created", status)
+}
+
+func TestMigration0017(t *testing.T) {
+	runMigrationTest(t, 17, migrationTest0017{})
+}
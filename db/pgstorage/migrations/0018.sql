@@ -0,0 +1,10 @@
+-- +migrate Up
+
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS sent_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS bumps BIGINT NOT NULL DEFAULT 0;
+UPDATE sync.monitored_txs SET sent_at = updated_at WHERE cardinality(history) > 0;
+
+-- +migrate Down
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS sent_at;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS bumps;
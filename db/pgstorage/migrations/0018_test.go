@@ -0,0 +1,57 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0018 struct{}
+
+const getReplacement0018 = `SELECT sent_at, updated_at, bumps FROM sync.monitored_txs WHERE deposit_id = $1;`
+
+func (m migrationTest0018) InsertData(db *sql.DB) error {
+	const txSQL = `INSERT INTO sync.monitored_txs
+		(deposit_id, from_addr, to_addr, nonce, value, "data", gas, status, history, created_at, updated_at)
+		VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('FE12ABAA190EF0C8638EE0BA9F828BF41368CA0E','hex'), 9, '0', decode('CCAA2D11','hex'), 200000, 'created', '{}', '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491'),
+		(2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('FE12ABAA190EF0C8638EE0BA9F828BF41368CA0E','hex'), 10, '0', decode('CCAA2D11','hex'), 200000, 'created', ARRAY[decode('2B0B5A2E5BD1B7F1B4E0A1C4F1F2D3C4B5A697887766554433221100FFEEDDCC','hex')], '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491');`
+	if _, err := db.Exec(txSQL); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0018) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var (
+		sentAt, updatedAt sql.NullTime
+		bumps             uint64
+	)
+	// The txs never sent have no sent time
+	assert.NoError(t, db.QueryRow(getReplacement0018, 1).Scan(&sentAt, &updatedAt, &bumps))
+	assert.False(t, sentAt.Valid)
+	assert.Equal(t, uint64(0), bumps)
+
+	// The txs sent were sent the last time they were updated
+	assert.NoError(t, db.QueryRow(getReplacement0018, 2).Scan(&sentAt, &updatedAt, &bumps))
+	assert.True(t, sentAt.Valid)
+	assert.Equal(t, updatedAt.Time, sentAt.Time)
+	assert.Equal(t, uint64(0), bumps)
+
+	_, err := db.Exec(`UPDATE sync.monitored_txs SET bumps = 3 WHERE deposit_id = 2;`)
+	assert.NoError(t, err)
+	assert.NoError(t, db.QueryRow(getReplacement0018, 2).Scan(&sentAt, &updatedAt, &bumps))
+	assert.Equal(t, uint64(3), bumps)
+}
+
+func (m migrationTest0018) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var bumps uint64
+	assert.Error(t, db.QueryRow(`SELECT bumps FROM sync.monitored_txs WHERE deposit_id = 1;`).Scan(&bumps))
+	var status string
+	assert.NoError(t, db.QueryRow(`SELECT status FROM sync.monitored_txs WHERE deposit_id = 2;`).Scan(&status))
+	assert.Equal(t, "created", status)
+}
+
+func TestMigration0018(t *testing.T) {
+	runMigrationTest(t, 18, migrationTest0018{})
+}
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS sync.claim_nonces
+(
+    network_id BIGINT NOT NULL,
+    signer     BYTEA  NOT NULL,
+    next_nonce BIGINT NOT NULL,
+    PRIMARY KEY (network_id, signer)
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.claim_nonces;
@@ -0,0 +1,39 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0019 struct{}
+
+const reserveNonce0019 = `INSERT INTO sync.claim_nonces (network_id, signer, next_nonce) VALUES ($1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), $2);`
+
+func (m migrationTest0019) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0019) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	_, err := db.Exec(reserveNonce0019, 1, 5)
+	assert.NoError(t, err)
+	// The same signer has its own nonces in each network
+	_, err = db.Exec(reserveNonce0019, 2, 3)
+	assert.NoError(t, err)
+	_, err = db.Exec(reserveNonce0019, 1, 6)
+	assert.Error(t, err)
+
+	var nextNonce uint64
+	assert.NoError(t, db.QueryRow(`SELECT next_nonce FROM sync.claim_nonces WHERE network_id = 1;`).Scan(&nextNonce))
+	assert.Equal(t, uint64(5), nextNonce)
+}
+
+func (m migrationTest0019) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec(reserveNonce0019, 1, 5)
+	assert.Error(t, err)
+}
+
+func TestMigration0019(t *testing.T) {
+	runMigrationTest(t, 19, migrationTest0019{})
+}
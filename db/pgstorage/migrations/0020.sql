@@ -0,0 +1,17 @@
+-- +migrate Up
+
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS gas_used BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS effective_gas_price VARCHAR;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS fee VARCHAR;
+ALTER TABLE sync.monitored_txs_group ADD COLUMN IF NOT EXISTS gas_used BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.monitored_txs_group ADD COLUMN IF NOT EXISTS effective_gas_price VARCHAR;
+ALTER TABLE sync.monitored_txs_group ADD COLUMN IF NOT EXISTS fee VARCHAR;
+
+-- +migrate Down
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS gas_used;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS effective_gas_price;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS fee;
+ALTER TABLE sync.monitored_txs_group DROP COLUMN IF EXISTS gas_used;
+ALTER TABLE sync.monitored_txs_group DROP COLUMN IF EXISTS effective_gas_price;
+ALTER TABLE sync.monitored_txs_group DROP COLUMN IF EXISTS fee;
@@ -0,0 +1,63 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0020 struct{}
+
+const (
+	getTxCost0020    = `SELECT gas_used, effective_gas_price, fee FROM sync.monitored_txs WHERE deposit_id = $1;`
+	getGroupCost0020 = `SELECT gas_used, effective_gas_price, fee FROM sync.monitored_txs_group WHERE group_id = $1;`
+)
+
+func (m migrationTest0020) InsertData(db *sql.DB) error {
+	const txSQL = `INSERT INTO sync.monitored_txs
+		(deposit_id, from_addr, to_addr, nonce, value, "data", gas, status, history, created_at, updated_at)
+		VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('FE12ABAA190EF0C8638EE0BA9F828BF41368CA0E','hex'), 9, '0', decode('CCAA2D11','hex'), 200000, 'confirmed', '{}', '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491');`
+	if _, err := db.Exec(txSQL); err != nil {
+		return err
+	}
+	const groupSQL = `INSERT INTO sync.monitored_txs_group
+		(group_id, status, deposit_ids, num_retries, created_at, updated_at)
+		VALUES(1, 'confirmed', '{1}', 1, '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491');`
+	if _, err := db.Exec(groupSQL); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0020) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var (
+		gasUsed                uint64
+		effectiveGasPrice, fee sql.NullString
+	)
+	// The cost of the existing claims is unknown
+	assert.NoError(t, db.QueryRow(getTxCost0020, 1).Scan(&gasUsed, &effectiveGasPrice, &fee))
+	assert.Equal(t, uint64(0), gasUsed)
+	assert.False(t, effectiveGasPrice.Valid)
+	assert.False(t, fee.Valid)
+	assert.NoError(t, db.QueryRow(getGroupCost0020, 1).Scan(&gasUsed, &effectiveGasPrice, &fee))
+	assert.Equal(t, uint64(0), gasUsed)
+	assert.False(t, fee.Valid)
+
+	_, err := db.Exec(`UPDATE sync.monitored_txs SET gas_used = 100, effective_gas_price = '10', fee = '1000' WHERE deposit_id = 1;`)
+	assert.NoError(t, err)
+	assert.NoError(t, db.QueryRow(getTxCost0020, 1).Scan(&gasUsed, &effectiveGasPrice, &fee))
+	assert.Equal(t, uint64(100), gasUsed)
+	assert.Equal(t, "10", effectiveGasPrice.String)
+	assert.Equal(t, "1000", fee.String)
+}
+
+func (m migrationTest0020) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var gasUsed uint64
+	assert.Error(t, db.QueryRow(`SELECT gas_used FROM sync.monitored_txs WHERE deposit_id = 1;`).Scan(&gasUsed))
+	assert.Error(t, db.QueryRow(`SELECT gas_used FROM sync.monitored_txs_group WHERE group_id = 1;`).Scan(&gasUsed))
+}
+
+func TestMigration0020(t *testing.T) {
+	runMigrationTest(t, 20, migrationTest0020{})
+}
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS sync.rejected_claims
+(
+    deposit_id BIGINT PRIMARY KEY REFERENCES sync.deposit (id) ON DELETE CASCADE,
+    reason     VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.rejected_claims;
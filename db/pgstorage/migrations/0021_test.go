@@ -0,0 +1,52 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0021 struct{}
+
+const addRejectedClaim0021 = `INSERT INTO sync.rejected_claims (deposit_id, reason, created_at, updated_at) VALUES ($1, 'token is not sponsored', NOW(), NOW());`
+
+func (m migrationTest0021) InsertData(db *sql.DB) error {
+	const blockSQL = `INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at)
+		VALUES(21, 21, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '2023-10-03 10:29:08.283');`
+	if _, err := db.Exec(blockSQL); err != nil {
+		return err
+	}
+	const depositSQL = `INSERT INTO sync.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+		VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '10000000000000000000', 1, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 21, 21, decode('C2D6575EA98EB55E36B5AC6E11196800362594458A4B3143DB50E4995CB2422E','hex'), decode('','hex'), 21, true);`
+	_, err := db.Exec(depositSQL)
+	return err
+}
+
+func (m migrationTest0021) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	_, err := db.Exec(addRejectedClaim0021, 21)
+	assert.NoError(t, err)
+	// The rejected deposit must exist
+	_, err = db.Exec(addRejectedClaim0021, 22)
+	assert.Error(t, err)
+
+	var reason string
+	assert.NoError(t, db.QueryRow(`SELECT reason FROM sync.rejected_claims WHERE deposit_id = 21;`).Scan(&reason))
+	assert.Equal(t, "token is not sponsored", reason)
+
+	// The rejection is removed with the deposit
+	_, err = db.Exec(`DELETE FROM sync.block WHERE id = 21;`)
+	assert.NoError(t, err)
+	var count int
+	assert.NoError(t, db.QueryRow(`SELECT count(*) FROM sync.rejected_claims;`).Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func (m migrationTest0021) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec(addRejectedClaim0021, 21)
+	assert.Error(t, err)
+}
+
+func TestMigration0021(t *testing.T) {
+	runMigrationTest(t, 21, migrationTest0021{})
+}
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- The nonces of the existing txs were reserved when the txs were created
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS nonce_reserved BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- +migrate Down
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS nonce_reserved;
@@ -0,0 +1,41 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0022 struct{}
+
+const getNonceReserved0022 = `SELECT nonce_reserved FROM sync.monitored_txs WHERE deposit_id = $1;`
+
+func (m migrationTest0022) InsertData(db *sql.DB) error {
+	const txSQL = `INSERT INTO sync.monitored_txs
+		(deposit_id, from_addr, to_addr, nonce, value, "data", gas, status, history, created_at, updated_at)
+		VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('FE12ABAA190EF0C8638EE0BA9F828BF41368CA0E','hex'), 9, '0', decode('CCAA2D11','hex'), 200000, 'created', '{}', '2023-10-03 10:29:08.283', '2023-10-03 10:29:09.491');`
+	_, err := db.Exec(txSQL)
+	return err
+}
+
+func (m migrationTest0022) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var nonceReserved bool
+	// The nonces of the existing txs are reserved
+	assert.NoError(t, db.QueryRow(getNonceReserved0022, 1).Scan(&nonceReserved))
+	assert.True(t, nonceReserved)
+
+	_, err := db.Exec(`UPDATE sync.monitored_txs SET nonce_reserved = FALSE WHERE deposit_id = 1;`)
+	assert.NoError(t, err)
+	assert.NoError(t, db.QueryRow(getNonceReserved0022, 1).Scan(&nonceReserved))
+	assert.False(t, nonceReserved)
+}
+
+func (m migrationTest0022) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var nonceReserved bool
+	assert.Error(t, db.QueryRow(getNonceReserved0022, 1).Scan(&nonceReserved))
+}
+
+func TestMigration0022(t *testing.T) {
+	runMigrationTest(t, 22, migrationTest0022{})
+}
@@ -0,0 +1,17 @@
+-- +migrate Up
+
+-- The rule of the existing rejections is taken from their reason
+ALTER TABLE sync.rejected_claims ADD COLUMN IF NOT EXISTS rule VARCHAR;
+UPDATE sync.rejected_claims SET rule = CASE
+    WHEN reason LIKE 'message sender %' THEN 'message'
+    WHEN reason LIKE 'destination address %' THEN 'destination'
+    WHEN reason LIKE 'token %' THEN 'token'
+    WHEN reason LIKE 'amount %' THEN 'min_amount'
+    WHEN reason LIKE 'daily budget %' THEN 'budget'
+    ELSE ''
+END WHERE rule IS NULL;
+ALTER TABLE sync.rejected_claims ALTER COLUMN rule SET NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE sync.rejected_claims DROP COLUMN IF EXISTS rule;
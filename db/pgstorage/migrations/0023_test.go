@@ -0,0 +1,57 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0023 struct{}
+
+const getRule0023 = `SELECT rule FROM sync.rejected_claims WHERE deposit_id = $1;`
+
+func (m migrationTest0023) InsertData(db *sql.DB) error {
+	const blockSQL = `INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at)
+		VALUES(23, 23, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '2023-10-03 10:29:08.283');`
+	if _, err := db.Exec(blockSQL); err != nil {
+		return err
+	}
+	const depositSQL = `INSERT INTO sync.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+		VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '10000000000000000000', 1, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 23, $1, decode('C2D6575EA98EB55E36B5AC6E11196800362594458A4B3143DB50E4995CB2422E','hex'), decode('','hex'), $1, true);`
+	const rejectedClaimSQL = `INSERT INTO sync.rejected_claims (deposit_id, reason, created_at, updated_at) VALUES ($1, $2, NOW(), NOW());`
+	for id, reason := range map[int]string{23: "daily budget 10 of the token is spent", 24: "token 0x0000000000000000000000000000000000000000 of network 0 is not sponsored"} {
+		if _, err := db.Exec(depositSQL, id); err != nil {
+			return err
+		}
+		if _, err := db.Exec(rejectedClaimSQL, id, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m migrationTest0023) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var rule string
+	// The rule of the existing rejections is taken from their reason
+	assert.NoError(t, db.QueryRow(getRule0023, 23).Scan(&rule))
+	assert.Equal(t, "budget", rule)
+	assert.NoError(t, db.QueryRow(getRule0023, 24).Scan(&rule))
+	assert.Equal(t, "token", rule)
+
+	// The rule is required
+	_, err := db.Exec(`UPDATE sync.rejected_claims SET rule = NULL WHERE deposit_id = 23;`)
+	assert.Error(t, err)
+}
+
+func (m migrationTest0023) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var rule string
+	assert.Error(t, db.QueryRow(getRule0023, 23).Scan(&rule))
+	var count int
+	assert.NoError(t, db.QueryRow(`SELECT count(*) FROM sync.rejected_claims;`).Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+func TestMigration0023(t *testing.T) {
+	runMigrationTest(t, 23, migrationTest0023{})
+}
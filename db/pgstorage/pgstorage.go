@@ -369,6 +369,17 @@ func (p *PostgresStorage) GetLatestTrustedExitRoot(ctx context.Context, networkI
 	return &ger, nil
 }
 
+// GetGERAllowed returns whether a global exit root synced in a network is allowed to be used to claim.
+func (p *PostgresStorage) GetGERAllowed(ctx context.Context, ger common.Hash, networkID uint32, dbTx pgx.Tx) (bool, error) {
+	var allowed bool
+	const getGERAllowedSQL = "SELECT allowed FROM sync.exit_root WHERE global_exit_root = $1 AND network_id = $2 ORDER BY id DESC LIMIT 1"
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getGERAllowedSQL, ger, networkID).Scan(&allowed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, gerror.ErrStorageNotFound
+	}
+	return allowed, err
+}
+
 // GetTokenWrapped gets a specific wrapped token.
 func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork uint32, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
 	const getWrappedTokenSQL = "SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM sync.token_wrapped WHERE orig_net = $1 AND orig_token_addr = $2"
@@ -378,30 +389,98 @@ func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork u
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
+	if err := p.fillTokenWrappedMetadata(ctx, &token, dbTx); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetTokenWrappedByAddress gets a wrapped token by its address in the network where it was created.
+func (p *PostgresStorage) GetTokenWrappedByAddress(ctx context.Context, networkID uint32, wrappedTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	const getWrappedTokenByAddressSQL = "SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM sync.token_wrapped WHERE wrapped_token_addr = $1 AND network_id = $2"
+
+	var token etherman.TokenWrapped
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getWrappedTokenByAddressSQL, wrappedTokenAddress, networkID).Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := p.fillTokenWrappedMetadata(ctx, &token, dbTx); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetTokensWrapped gets the wrapped tokens, optionally filtered by the network where they were created
+// and by the original network.
+func (p *PostgresStorage) GetTokensWrapped(ctx context.Context, networkID, originalNetwork *uint32, limit, offset uint32, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error) {
+	const getTokensWrappedSQL = `SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM sync.token_wrapped
+		WHERE ($1::BIGINT IS NULL OR network_id = $1) AND ($2::BIGINT IS NULL OR orig_net = $2)
+		ORDER BY block_id ASC, network_id ASC, wrapped_token_addr ASC LIMIT $3 OFFSET $4`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getTokensWrappedSQL, networkID, originalNetwork, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := []*etherman.TokenWrapped{}
+	for rows.Next() {
+		var token etherman.TokenWrapped
+		err = rows.Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+	rows.Close()
+
+	for _, token := range tokens {
+		if err := p.fillTokenWrappedMetadata(ctx, token, dbTx); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
+
+// GetTokensWrappedCount gets the number of wrapped tokens, optionally filtered by the network where they were
+// created and by the original network.
+func (p *PostgresStorage) GetTokensWrappedCount(ctx context.Context, networkID, originalNetwork *uint32, dbTx pgx.Tx) (uint64, error) {
+	const getTokensWrappedCountSQL = "SELECT count(*) FROM sync.token_wrapped WHERE ($1::BIGINT IS NULL OR network_id = $1) AND ($2::BIGINT IS NULL OR orig_net = $2)"
+	var count uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getTokensWrappedCountSQL, networkID, originalNetwork).Scan(&count)
+	return count, err
+}
 
+// fillTokenWrappedMetadata decodes the name, symbol and decimals of a wrapped token from the deposit metadata if they are missing.
+func (p *PostgresStorage) fillTokenWrappedMetadata(ctx context.Context, token *etherman.TokenWrapped, dbTx pgx.Tx) error {
 	// this is due to missing the related deposit in the opposite network in fast sync mode.
 	// ref: https://github.com/fiwallets/zkevm-bridge-service/issues/230
-	if token.Symbol == "" {
-		metadata, err := p.GetTokenMetadata(ctx, token.OriginalNetwork, token.NetworkID, token.OriginalTokenAddress, dbTx)
-		var tokenMetadata *etherman.TokenMetadata
-		if err != nil {
-			if err != pgx.ErrNoRows {
-				return nil, err
-			}
-		} else {
-			tokenMetadata, err = getDecodedToken(metadata)
-			if err != nil {
-				return nil, err
-			}
-			updateWrappedTokenSQL := "UPDATE sync.token_wrapped SET name = $3, symbol = $4, decimals = $5  WHERE orig_net = $1 AND orig_token_addr = $2" //nolint: gosec
-			_, err = p.getExecQuerier(dbTx).Exec(ctx, updateWrappedTokenSQL, originalNetwork, originalTokenAddress, tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals)
-			if err != nil {
-				return nil, err
-			}
-			token.Name, token.Symbol, token.Decimals = tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals
+	if token.Symbol != "" {
+		return nil
+	}
+	metadata, err := p.GetTokenMetadata(ctx, token.OriginalNetwork, token.NetworkID, token.OriginalTokenAddress, dbTx)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			return err
 		}
+		return nil
 	}
-	return &token, err
+	tokenMetadata, err := getDecodedToken(metadata)
+	if err != nil {
+		return err
+	}
+	updateWrappedTokenSQL := "UPDATE sync.token_wrapped SET name = $3, symbol = $4, decimals = $5  WHERE orig_net = $1 AND orig_token_addr = $2" //nolint: gosec
+	_, err = p.getExecQuerier(dbTx).Exec(ctx, updateWrappedTokenSQL, token.OriginalNetwork, token.OriginalTokenAddress, tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals)
+	if err != nil {
+		return err
+	}
+	token.Name, token.Symbol, token.Decimals = tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals
+	return nil
 }
 
 // GetDepositCountByRoot gets the deposit count by the root.
@@ -662,10 +741,12 @@ func (p *PostgresStorage) GetLatestTrustedGERByDeposit(ctx context.Context, depo
 // AddClaimTx adds a claim monitored transaction to the storage.
 func (p *PostgresStorage) AddClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
 	const addMonitoredTxSQL = `INSERT INTO sync.monitored_txs 
-		(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, group_id, global_exit_root)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+		(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, group_id, global_exit_root, gas_price, gas_tip_cap, gas_fee_cap, sent_at, bumps, gas_used, effective_gas_price, fee, nonce_reserved)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`
 	_, err := p.getExecQuerier(dbTx).Exec(ctx, addMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(),
-		mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), time.Now().UTC(), mTx.GroupID, mTx.GlobalExitRoot)
+		mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), time.Now().UTC(), mTx.GroupID, mTx.GlobalExitRoot,
+		bigIntToNullString(mTx.GasPrice), bigIntToNullString(mTx.GasTipCap), bigIntToNullString(mTx.GasFeeCap), mTx.SentAt, mTx.Bumps,
+		mTx.Cost.GasUsed, bigIntToNullString(mTx.Cost.EffectiveGasPrice), bigIntToNullString(mTx.Cost.Fee), mTx.NonceReserved)
 	return err
 }
 
@@ -682,15 +763,26 @@ func (p *PostgresStorage) UpdateClaimTx(ctx context.Context, mTx ctmtypes.Monito
 		, history = $9
 		, updated_at = $10
 		, group_id = $11
+		, gas_price = $12
+		, gas_tip_cap = $13
+		, gas_fee_cap = $14
+		, sent_at = $15
+		, bumps = $16
+		, gas_used = $17
+		, effective_gas_price = $18
+		, fee = $19
+		, nonce_reserved = $20
 		WHERE deposit_id = $1`
 	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(),
-		mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), mTx.GroupID)
+		mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), mTx.GroupID,
+		bigIntToNullString(mTx.GasPrice), bigIntToNullString(mTx.GasTipCap), bigIntToNullString(mTx.GasFeeCap), mTx.SentAt, mTx.Bumps,
+		mTx.Cost.GasUsed, bigIntToNullString(mTx.Cost.EffectiveGasPrice), bigIntToNullString(mTx.Cost.Fee), mTx.NonceReserved)
 	return err
 }
 
 // GetClaimTxsByStatus gets the monitored transactions by status.
 func (p *PostgresStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, rollupID uint32, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error) {
-	const getMonitoredTxsSQL = "SELECT deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, group_id, global_exit_root FROM sync.monitored_txs INNER JOIN sync.deposit ON sync.deposit.id = sync.monitored_txs.deposit_id WHERE status = ANY($1) AND sync.deposit.dest_net = $2 ORDER BY created_at ASC"
+	const getMonitoredTxsSQL = "SELECT deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, group_id, global_exit_root, gas_price, gas_tip_cap, gas_fee_cap, sent_at, bumps, gas_used, effective_gas_price, fee, nonce_reserved FROM sync.monitored_txs INNER JOIN sync.deposit ON sync.deposit.id = sync.monitored_txs.deposit_id WHERE status = ANY($1) AND sync.deposit.dest_net = $2 ORDER BY created_at ASC"
 	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsSQL, pq.Array(statuses), rollupID)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return []ctmtypes.MonitoredTx{}, nil
@@ -701,15 +793,23 @@ func (p *PostgresStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ct
 	mTxs := make([]ctmtypes.MonitoredTx, 0, len(rows.RawValues()))
 	for rows.Next() {
 		var (
-			value   string
-			history [][]byte
+			value                          string
+			history                        [][]byte
+			gasPrice, gasTipCap, gasFeeCap *string
+			effectiveGasPrice, fee         *string
 		)
 		mTx := ctmtypes.MonitoredTx{}
-		err = rows.Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt, &mTx.GroupID, &mTx.GlobalExitRoot)
+		err = rows.Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt, &mTx.GroupID, &mTx.GlobalExitRoot,
+			&gasPrice, &gasTipCap, &gasFeeCap, &mTx.SentAt, &mTx.Bumps, &mTx.Cost.GasUsed, &effectiveGasPrice, &fee, &mTx.NonceReserved)
 		if err != nil {
 			return mTxs, err
 		}
 		mTx.Value, _ = new(big.Int).SetString(value, 10) //nolint:gomnd
+		mTx.GasPrice = nullStringToBigInt(gasPrice)
+		mTx.GasTipCap = nullStringToBigInt(gasTipCap)
+		mTx.GasFeeCap = nullStringToBigInt(gasFeeCap)
+		mTx.Cost.EffectiveGasPrice = nullStringToBigInt(effectiveGasPrice)
+		mTx.Cost.Fee = nullStringToBigInt(fee)
 		mTx.History = make(map[common.Hash]bool)
 		for _, h := range history {
 			mTx.History[common.BytesToHash(h)] = true
@@ -720,6 +820,284 @@ func (p *PostgresStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ct
 	return mTxs, nil
 }
 
+// GetClaimTxsCountByStatus gets the number of monitored transactions of a rollup in each status.
+func (p *PostgresStorage) GetClaimTxsCountByStatus(ctx context.Context, rollupID uint32, dbTx pgx.Tx) (map[ctmtypes.MonitoredTxStatus]uint64, error) {
+	const getMonitoredTxsCountSQL = "SELECT status, count(*) FROM sync.monitored_txs INNER JOIN sync.deposit ON sync.deposit.id = sync.monitored_txs.deposit_id WHERE sync.deposit.dest_net = $1 GROUP BY status"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsCountSQL, rollupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[ctmtypes.MonitoredTxStatus]uint64)
+	for rows.Next() {
+		var (
+			status ctmtypes.MonitoredTxStatus
+			count  uint64
+		)
+		if err = rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+// GetClaimTxsCountBySigner gets the number of monitored transactions of a rollup in the statuses sent by each signer.
+func (p *PostgresStorage) GetClaimTxsCountBySigner(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, rollupID uint32, dbTx pgx.Tx) (map[common.Address]uint64, error) {
+	const getMonitoredTxsCountBySignerSQL = "SELECT from_addr, count(*) FROM sync.monitored_txs INNER JOIN sync.deposit ON sync.deposit.id = sync.monitored_txs.deposit_id WHERE status = ANY($1) AND sync.deposit.dest_net = $2 GROUP BY from_addr"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsCountBySignerSQL, pq.Array(statuses), rollupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[common.Address]uint64)
+	for rows.Next() {
+		var (
+			signer common.Address
+			count  uint64
+		)
+		if err = rows.Scan(&signer, &count); err != nil {
+			return nil, err
+		}
+		counts[signer] = count
+	}
+	return counts, rows.Err()
+}
+
+// GetClaimCosts gets the cost of the claims finished in the time range, aggregated by day in UTC, token,
+// destination network and signer. The claims without mined txs are not included.
+func (p *PostgresStorage) GetClaimCosts(ctx context.Context, from, to time.Time, dbTx pgx.Tx) ([]*ctmtypes.ClaimCost, error) {
+	const getClaimCostsSQL = `SELECT date_trunc('day', mt.updated_at AT TIME ZONE 'UTC') AS day, d.orig_net, d.orig_addr, d.dest_net, mt.from_addr,
+		count(*), SUM(mt.gas_used)::BIGINT, SUM(mt.fee::NUMERIC)::VARCHAR
+		FROM sync.monitored_txs AS mt
+		INNER JOIN sync.deposit AS d ON d.id = mt.deposit_id
+		WHERE mt.fee IS NOT NULL AND mt.updated_at >= $1 AND mt.updated_at < $2
+		GROUP BY day, d.orig_net, d.orig_addr, d.dest_net, mt.from_addr
+		ORDER BY day, d.orig_net, d.orig_addr, d.dest_net, mt.from_addr`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimCostsSQL, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var costs []*ctmtypes.ClaimCost
+	for rows.Next() {
+		var (
+			cost ctmtypes.ClaimCost
+			fee  string
+		)
+		err = rows.Scan(&cost.Day, &cost.OriginalNetwork, &cost.OriginalAddress, &cost.DestinationNetwork, &cost.Signer,
+			&cost.Claims, &cost.GasUsed, &fee)
+		if err != nil {
+			return nil, err
+		}
+		cost.Fee, _ = new(big.Int).SetString(fee, 10) //nolint:gomnd
+		costs = append(costs, &cost)
+	}
+	return costs, rows.Err()
+}
+
+// GetTokenClaimsFee gets the fee paid by the claims of the asset deposits of a token to the destination
+// network finished since the given time.
+func (p *PostgresStorage) GetTokenClaimsFee(ctx context.Context, originalNetwork uint32, originalAddress common.Address, destinationNetwork uint32, since time.Time, dbTx pgx.Tx) (*big.Int, error) {
+	const getTokenClaimsFeeSQL = `SELECT COALESCE(SUM(mt.fee::NUMERIC), 0)::VARCHAR
+		FROM sync.monitored_txs AS mt
+		INNER JOIN sync.deposit AS d ON d.id = mt.deposit_id
+		WHERE mt.fee IS NOT NULL AND mt.updated_at >= $4 AND d.leaf_type = 0 AND d.orig_net = $1 AND d.orig_addr = $2 AND d.dest_net = $3`
+	return p.getClaimsFee(ctx, getTokenClaimsFeeSQL, dbTx, originalNetwork, originalAddress, destinationNetwork, since)
+}
+
+// GetDestinationClaimsFee gets the fee paid by the claims of the deposits to the destination address
+// finished since the given time.
+func (p *PostgresStorage) GetDestinationClaimsFee(ctx context.Context, destinationAddress common.Address, destinationNetwork uint32, since time.Time, dbTx pgx.Tx) (*big.Int, error) {
+	const getDestinationClaimsFeeSQL = `SELECT COALESCE(SUM(mt.fee::NUMERIC), 0)::VARCHAR
+		FROM sync.monitored_txs AS mt
+		INNER JOIN sync.deposit AS d ON d.id = mt.deposit_id
+		WHERE mt.fee IS NOT NULL AND mt.updated_at >= $3 AND d.dest_addr = $1 AND d.dest_net = $2`
+	return p.getClaimsFee(ctx, getDestinationClaimsFeeSQL, dbTx, destinationAddress, destinationNetwork, since)
+}
+
+func (p *PostgresStorage) getClaimsFee(ctx context.Context, query string, dbTx pgx.Tx, args ...interface{}) (*big.Int, error) {
+	var fee string
+	if err := p.getExecQuerier(dbTx).QueryRow(ctx, query, args...).Scan(&fee); err != nil {
+		return nil, err
+	}
+	feeInt, ok := new(big.Int).SetString(fee, 10) //nolint:gomnd
+	if !ok {
+		return nil, fmt.Errorf("invalid claims fee %s", fee)
+	}
+	return feeInt, nil
+}
+
+// AddRejectedClaim records the rule and the reason a deposit is not claimed. It returns false if the
+// deposit was already rejected with the same reason, the time of the rejection is updated anyway.
+func (p *PostgresStorage) AddRejectedClaim(ctx context.Context, depositID uint64, rule, reason string, dbTx pgx.Tx) (bool, error) {
+	const addRejectedClaimSQL = `WITH previous AS (SELECT reason FROM sync.rejected_claims WHERE deposit_id = $1)
+		INSERT INTO sync.rejected_claims (deposit_id, rule, reason, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (deposit_id) DO UPDATE SET rule = EXCLUDED.rule, reason = EXCLUDED.reason, updated_at = EXCLUDED.updated_at
+		RETURNING (SELECT reason FROM previous)`
+	var previousReason *string
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, addRejectedClaimSQL, depositID, rule, reason, time.Now().UTC()).Scan(&previousReason)
+	if err != nil {
+		return false, err
+	}
+	return previousReason == nil || *previousReason != reason, nil
+}
+
+// DeleteRejectedClaim removes the rejection of a deposit.
+func (p *PostgresStorage) DeleteRejectedClaim(ctx context.Context, depositID uint64, dbTx pgx.Tx) error {
+	const deleteRejectedClaimSQL = "DELETE FROM sync.rejected_claims WHERE deposit_id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, deleteRejectedClaimSQL, depositID)
+	return err
+}
+
+// GetRejectedL1Deposits gets the L1 deposits to a network ready to be claimed, rejected by the rule
+// before the given time and without a monitored tx.
+func (p *PostgresStorage) GetRejectedL1Deposits(ctx context.Context, rule string, destinationNetwork uint32, before time.Time, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getRejectedL1DepositsSQL = `SELECT d.id, d.leaf_type, d.orig_net, d.orig_addr, d.amount, d.dest_net, d.dest_addr, d.deposit_cnt, d.block_id, d.network_id, d.tx_hash, d.metadata, d.ready_for_claim
+		FROM sync.deposit AS d
+		INNER JOIN sync.rejected_claims AS rc ON rc.deposit_id = d.id
+		WHERE rc.rule = $1 AND rc.updated_at < $3 AND d.network_id = 0 AND d.dest_net = $2 AND d.ready_for_claim = true
+			AND NOT EXISTS (SELECT 1 FROM sync.monitored_txs AS mt WHERE mt.deposit_id = d.id)
+		ORDER BY d.id`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getRejectedL1DepositsSQL, rule, destinationNetwork, before)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeposits(rows, false)
+}
+
+// ReserveNonce reserves the next nonce of a claim signer in a network. The reserved nonce is never
+// lower than minNonce. The reservation is released if the dbTx is rolled back and the concurrent
+// reservations of the same signer wait until the dbTx ends.
+func (p *PostgresStorage) ReserveNonce(ctx context.Context, networkID uint32, signer common.Address, minNonce uint64, dbTx pgx.Tx) (uint64, error) {
+	const reserveNonceSQL = `INSERT INTO sync.claim_nonces (network_id, signer, next_nonce) VALUES ($1, $2, $3 + 1)
+		ON CONFLICT (network_id, signer) DO UPDATE SET next_nonce = GREATEST(sync.claim_nonces.next_nonce, $3) + 1
+		RETURNING next_nonce - 1`
+	var nonce uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, reserveNonceSQL, networkID, signer, minNonce).Scan(&nonce)
+	return nonce, err
+}
+
+// GetNextNonce gets the next nonce to be reserved for a claim signer in a network.
+func (p *PostgresStorage) GetNextNonce(ctx context.Context, networkID uint32, signer common.Address, dbTx pgx.Tx) (uint64, error) {
+	const getNextNonceSQL = "SELECT next_nonce FROM sync.claim_nonces WHERE network_id = $1 AND signer = $2"
+	var nextNonce uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getNextNonceSQL, networkID, signer).Scan(&nextNonce)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return nextNonce, err
+}
+
+// GetDepositClaimStatus gets the claim lifecycle data of a deposit: its block time, whether it is
+// included in an L1 global exit root, the monitored tx and group created by the claim tx manager and the claim.
+func (p *PostgresStorage) GetDepositClaimStatus(ctx context.Context, depositCnt, networkID uint32, dbTx pgx.Tx) (*ctmtypes.DepositClaimStatus, error) {
+	const getDepositClaimStatusSQL = `SELECT b.received_at,
+		EXISTS (
+			SELECT 1 FROM sync.exit_root AS er
+			INNER JOIN mt.root AS r ON r.root = er.exit_roots[1] AND r.network = 0
+			INNER JOIN sync.deposit AS rd ON rd.id = r.deposit_id
+			WHERE d.network_id = 0 AND er.network_id = 0 AND er.block_id > 0 AND rd.deposit_cnt >= d.deposit_cnt
+		) OR EXISTS (
+			SELECT 1 FROM sync.exit_root AS er
+			INNER JOIN mt.rollup_exit AS re ON re.root = er.exit_roots[2] AND re.rollup_id = d.network_id
+			INNER JOIN mt.root AS r ON r.root = re.leaf AND r.network = d.network_id
+			INNER JOIN sync.deposit AS rd ON rd.id = r.deposit_id
+			WHERE d.network_id != 0 AND er.network_id = 0 AND er.block_id > 0 AND rd.deposit_cnt >= d.deposit_cnt
+		),
+		mt.deposit_id, mt.from_addr, mt.to_addr, mt.nonce, mt.gas, mt.status, mt.history, mt.created_at, mt.updated_at, mt.group_id, mt.global_exit_root,
+		g.status, g.num_retries, g.claim_tx_history, g.created_at, g.updated_at, g.last_log,
+		c.tx_hash, cb.received_at, rc.reason, rc.updated_at
+		FROM sync.deposit AS d
+		INNER JOIN sync.block AS b ON b.id = d.block_id
+		LEFT JOIN sync.monitored_txs AS mt ON mt.deposit_id = d.id
+		LEFT JOIN sync.monitored_txs_group AS g ON g.group_id = mt.group_id
+		LEFT JOIN sync.claim AS c ON c.index = d.deposit_cnt AND c.network_id = d.dest_net
+			AND ((d.network_id = 0 AND c.mainnet_flag) OR (d.network_id != 0 AND NOT c.mainnet_flag AND c.rollup_index + 1 = d.network_id))
+		LEFT JOIN sync.block AS cb ON cb.id = c.block_id
+		LEFT JOIN sync.rejected_claims AS rc ON rc.deposit_id = d.id
+		WHERE d.network_id = $1 AND d.deposit_cnt = $2`
+	var (
+		status            ctmtypes.DepositClaimStatus
+		mTxDepositID      *uint64
+		mTxFrom, mTxTo    []byte
+		mTxNonce, mTxGas  *uint64
+		mTxStatus         *string
+		mTxHistory        [][]byte
+		mTxCreatedAt      *time.Time
+		mTxUpdatedAt      *time.Time
+		mTxGroupID        *uint64
+		mTxGER            []byte
+		groupStatus       *string
+		groupNumRetries   *int32
+		groupTxHistoryStr *string
+		groupCreatedAt    *time.Time
+		groupUpdatedAt    *time.Time
+		groupLastLog      *string
+		claimTxHash       []byte
+	)
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositClaimStatusSQL, networkID, depositCnt).Scan(&status.DepositedAt, &status.IncludedInGER,
+		&mTxDepositID, &mTxFrom, &mTxTo, &mTxNonce, &mTxGas, &mTxStatus, pq.Array(&mTxHistory), &mTxCreatedAt, &mTxUpdatedAt, &mTxGroupID, &mTxGER,
+		&groupStatus, &groupNumRetries, &groupTxHistoryStr, &groupCreatedAt, &groupUpdatedAt, &groupLastLog,
+		&claimTxHash, &status.ClaimedAt, &status.RejectedReason, &status.RejectedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if mTxDepositID != nil {
+		mTx := &ctmtypes.MonitoredTx{
+			DepositID:      *mTxDepositID,
+			From:           common.BytesToAddress(mTxFrom),
+			Nonce:          *mTxNonce,
+			Gas:            *mTxGas,
+			Status:         ctmtypes.MonitoredTxStatus(*mTxStatus),
+			History:        make(map[common.Hash]bool),
+			CreatedAt:      *mTxCreatedAt,
+			UpdatedAt:      *mTxUpdatedAt,
+			GroupID:        mTxGroupID,
+			GlobalExitRoot: common.BytesToHash(mTxGER),
+		}
+		if mTxTo != nil {
+			to := common.BytesToAddress(mTxTo)
+			mTx.To = &to
+		}
+		for _, h := range mTxHistory {
+			mTx.History[common.BytesToHash(h)] = true
+		}
+		status.MonitoredTx = mTx
+	}
+	if mTxGroupID != nil && groupStatus != nil {
+		group := &ctmtypes.MonitoredTxGroupDBEntry{
+			GroupID:    *mTxGroupID,
+			Status:     ctmtypes.MonitoredTxGroupStatus(*groupStatus),
+			NumRetries: *groupNumRetries,
+			CreatedAt:  *groupCreatedAt,
+			UpdatedAt:  *groupUpdatedAt,
+		}
+		if groupLastLog != nil {
+			group.LastLog = *groupLastLog
+		}
+		if groupTxHistoryStr != nil {
+			group.ClaimTxHistory, err = ctmtypes.NewTxHistoryV2FromJson(*groupTxHistoryStr)
+			if err != nil {
+				return nil, fmt.Errorf("fails to convert claimTxHistory from json. Err: %w", err)
+			}
+		}
+		status.Group = group
+	}
+	if claimTxHash != nil {
+		hash := common.BytesToHash(claimTxHash)
+		status.ClaimTxHash = &hash
+	}
+
+	return &status, nil
+}
+
 // GetPendingDepositsToClaim gets the deposit list which is not claimed in the destination network.
 func (p *PostgresStorage) GetPendingDepositsToClaim(ctx context.Context, destAddress common.Address, destNetwork, leafType, limit, offset uint32, dbTx pgx.Tx) ([]*etherman.Deposit, uint64, error) {
 	desAddrSQL := ""
@@ -27,8 +27,8 @@ func (p *PostgresStorage) GetLatestMonitoredTxGroupID(ctx context.Context, dbTx
 // AddMonitoredTxsGroup
 func (p *PostgresStorage) AddMonitoredTxsGroup(ctx context.Context, mTxGroup *ctmtypes.MonitoredTxGroupDBEntry, dbTx pgx.Tx) error {
 	const sql = `INSERT INTO sync.monitored_txs_group 
-		(group_id,status, deposit_ids, num_retries, compressed_tx_data,claim_tx_history, created_at, updated_at) 
-		VALUES($1, $2, $3, $4,$5, $6, $7, $8)
+		(group_id,status, deposit_ids, num_retries, compressed_tx_data,claim_tx_history, created_at, updated_at, gas_used, effective_gas_price, fee) 
+		VALUES($1, $2, $3, $4,$5, $6, $7, $8, $9, $10, $11)
 		`
 	if mTxGroup == nil {
 		return errors.New("nil monitored tx group")
@@ -46,7 +46,10 @@ func (p *PostgresStorage) AddMonitoredTxsGroup(ctx context.Context, mTxGroup *ct
 		mTxGroup.CompressedTxData,
 		claimTxHistoryStr,
 		mTxGroup.CreatedAt,
-		mTxGroup.UpdatedAt)
+		mTxGroup.UpdatedAt,
+		mTxGroup.Cost.GasUsed,
+		bigIntToNullString(mTxGroup.Cost.EffectiveGasPrice),
+		bigIntToNullString(mTxGroup.Cost.Fee))
 
 	if err != nil {
 		return err
@@ -57,7 +60,8 @@ func (p *PostgresStorage) AddMonitoredTxsGroup(ctx context.Context, mTxGroup *ct
 
 func (p *PostgresStorage) UpdateMonitoredTxsGroup(ctx context.Context, mTxGroup *ctmtypes.MonitoredTxGroupDBEntry, dbTx pgx.Tx) error {
 	const sql = `UPDATE sync.monitored_txs_group 
-		SET num_retries = $2, compressed_tx_data = $3, claim_tx_history = $4, updated_at = $5, status = $6, last_log = $7
+		SET num_retries = $2, compressed_tx_data = $3, claim_tx_history = $4, updated_at = $5, status = $6, last_log = $7,
+		gas_used = $8, effective_gas_price = $9, fee = $10
 		WHERE group_id = $1
 		`
 	if mTxGroup == nil {
@@ -75,7 +79,10 @@ func (p *PostgresStorage) UpdateMonitoredTxsGroup(ctx context.Context, mTxGroup
 		claimTxHistoryStr,
 		mTxGroup.UpdatedAt,
 		mTxGroup.Status,
-		mTxGroup.LastLog)
+		mTxGroup.LastLog,
+		mTxGroup.Cost.GasUsed,
+		bigIntToNullString(mTxGroup.Cost.EffectiveGasPrice),
+		bigIntToNullString(mTxGroup.Cost.Fee))
 
 	if err != nil {
 		return err
@@ -85,7 +92,7 @@ func (p *PostgresStorage) UpdateMonitoredTxsGroup(ctx context.Context, mTxGroup
 }
 
 func (p *PostgresStorage) GetMonitoredTxsGroups(ctx context.Context, groupIds []uint64, dbTx pgx.Tx) (map[uint64]ctmtypes.MonitoredTxGroupDBEntry, error) {
-	const sql = "SELECT group_id, status, num_retries, compressed_tx_data,claim_tx_history, created_at, updated_at FROM sync.monitored_txs_group WHERE group_id = ANY($1) ORDER BY created_at ASC"
+	const sql = "SELECT group_id, status, num_retries, compressed_tx_data,claim_tx_history, created_at, updated_at, gas_used, effective_gas_price, fee FROM sync.monitored_txs_group WHERE group_id = ANY($1) ORDER BY created_at ASC"
 	groups := make(map[uint64]ctmtypes.MonitoredTxGroupDBEntry)
 	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, pq.Array(groupIds))
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -96,11 +103,17 @@ func (p *PostgresStorage) GetMonitoredTxsGroups(ctx context.Context, groupIds []
 
 	for rows.Next() {
 		var group ctmtypes.MonitoredTxGroupDBEntry
-		var claimTxHistoryStr string
-		err = rows.Scan(&group.GroupID, &group.Status, &group.NumRetries, &group.CompressedTxData, &claimTxHistoryStr, &group.CreatedAt, &group.UpdatedAt)
+		var (
+			claimTxHistoryStr      string
+			effectiveGasPrice, fee *string
+		)
+		err = rows.Scan(&group.GroupID, &group.Status, &group.NumRetries, &group.CompressedTxData, &claimTxHistoryStr, &group.CreatedAt, &group.UpdatedAt,
+			&group.Cost.GasUsed, &effectiveGasPrice, &fee)
 		if err != nil {
 			return nil, err
 		}
+		group.Cost.EffectiveGasPrice = nullStringToBigInt(effectiveGasPrice)
+		group.Cost.Fee = nullStringToBigInt(fee)
 		group.ClaimTxHistory, err = ctmtypes.NewTxHistoryV2FromJson(claimTxHistoryStr)
 		if err != nil {
 			return nil, fmt.Errorf("fails to convert claimTxHistory from json. Err: %w", err)
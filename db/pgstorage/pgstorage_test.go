@@ -8,6 +8,7 @@ import (
 
 	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -242,3 +243,284 @@ func TestGetPendingDepositsToClaim(t *testing.T) {
 	assert.Equal(t, uint64(2), deposits[0].Id)
 	assert.Equal(t, true, deposits[0].ReadyForClaim)
 }
+
+func TestGetDepositClaimStatus(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 1, decode('5C7832','hex'), decode('5C7830','hex'), 1, '1970-01-01 02:00:00.000');
+
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 0, decode('CBE7A77275EE22780BB94EA900D42CEF88F5A2F0E1A7C76696556D7FF17767E6','hex'), decode('','hex'), 1, true);
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 1, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 2, true);
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 2, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1E','hex'), decode('','hex'), 3, false);
+
+	INSERT INTO mt.root
+	(root, deposit_id, network)
+	VALUES(decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), 2, 0);
+	INSERT INTO sync.exit_root
+	(block_id, global_exit_root, exit_roots, network_id)
+	VALUES(1, decode('B598CE65AA15C08DDA126A2985BA54F0559EAAC562BB43BA430C7344261FBC5D','hex'), ARRAY[decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex')], 0);
+
+	INSERT INTO sync.monitored_txs_group
+	(group_id, status, deposit_ids, num_retries, compressed_tx_data, claim_tx_history, created_at, updated_at)
+	VALUES(1, 'claiming', '{2}', 0, NULL, '{"Version":1,"TxHashes":[{"TxHash":"0xbf2c816ab6f8a8f5f9dda6ee97d433cc841e69b5669a5cdf499826fa4b99c179","ReceiptStatus":null,"CreatedAt":"1970-01-01T03:00:00Z"}]}', '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000');
+	INSERT INTO sync.monitored_txs
+	(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, group_id)
+	VALUES(2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'claimiming', NULL, '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000', 1);
+
+	INSERT INTO sync.claim
+	(network_id, "index", orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, rollup_index, mainnet_flag)
+	VALUES(1, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, decode('BF2C816AB6F8A8F5F9DDA6EE97D433CC841E69B5669A5CDF499826FA4B99C179','hex'), 0, true);
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	// Claimed deposit
+	status, err := store.GetDepositClaimStatus(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.ClaimTxHash)
+	assert.Equal(t, common.HexToHash("0xBF2C816AB6F8A8F5F9DDA6EE97D433CC841E69B5669A5CDF499826FA4B99C179"), *status.ClaimTxHash)
+	require.NotNil(t, status.ClaimedAt)
+	assert.Nil(t, status.MonitoredTx)
+	assert.Nil(t, status.Group)
+	assert.True(t, status.IncludedInGER)
+
+	// Deposit being claimed by the claim tx manager
+	status, err = store.GetDepositClaimStatus(ctx, 1, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, status.ClaimTxHash)
+	assert.True(t, status.IncludedInGER)
+	require.NotNil(t, status.MonitoredTx)
+	assert.Equal(t, uint64(2), status.MonitoredTx.DepositID)
+	assert.Equal(t, ctmtypes.MonitoredTxStatusClaiming, status.MonitoredTx.Status)
+	require.NotNil(t, status.Group)
+	assert.Equal(t, uint64(1), status.Group.GroupID)
+	assert.Equal(t, ctmtypes.MonitoredTxGroupStatusClaiming, status.Group.Status)
+	require.NotNil(t, status.Group.ClaimTxHistory)
+	assert.Equal(t, 1, len(status.Group.ClaimTxHistory.TxHashes))
+
+	// Deposit not included in any GER yet
+	status, err = store.GetDepositClaimStatus(ctx, 2, 0, nil)
+	require.NoError(t, err)
+	assert.False(t, status.IncludedInGER)
+	assert.Nil(t, status.MonitoredTx)
+
+	_, err = store.GetDepositClaimStatus(ctx, 3, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+}
+
+func TestReserveNonce(t *testing.T) {
+	store := createStore(t)
+	ctx := context.Background()
+	signer := common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266")
+
+	_, err := store.GetNextNonce(ctx, 1, signer, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	nonce, err := store.ReserveNonce(ctx, 1, signer, 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), nonce)
+	// The reserved nonces are never reused
+	nonce, err = store.ReserveNonce(ctx, 1, signer, 3, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), nonce)
+	// The nonces used outside the allocator are skipped
+	nonce, err = store.ReserveNonce(ctx, 1, signer, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), nonce)
+	nextNonce, err := store.GetNextNonce(ctx, 1, signer, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), nextNonce)
+
+	// The signer has its own nonces in each network
+	nonce, err = store.ReserveNonce(ctx, 2, signer, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonce)
+
+	// The reservation is released when the dbTx is rolled back
+	dbTx, err := store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	nonce, err = store.ReserveNonce(ctx, 1, signer, 0, dbTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), nonce)
+	require.NoError(t, store.Rollback(ctx, dbTx))
+	nonce, err = store.ReserveNonce(ctx, 1, signer, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), nonce)
+}
+
+func TestGetClaimTxsCountBySigner(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 0, decode('CBE7A77275EE22780BB94EA900D42CEF88F5A2F0E1A7C76696556D7FF17767E6','hex'), decode('','hex'), 1, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 1, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 2, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 2, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 3, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 3, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 4, true);
+
+	INSERT INTO sync.monitored_txs
+	(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at)
+	VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'created', NULL, '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000'),
+	(2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000'),
+	(3, decode('70997970C51812DC3A010C7D01B50E0D17DC79C8','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'created', NULL, '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000'),
+	(4, decode('70997970C51812DC3A010C7D01B50E0D17DC79C8','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, '0', NULL, 100, 'created', NULL, '1970-01-01 03:00:00.000', '1970-01-01 03:00:00.000');
+	`
+	store := createStore(t)
+	ctx := context.Background()
+	_, err := store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	counts, err := store.GetClaimTxsCountBySigner(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[common.Address]uint64{
+		common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"): 1,
+		common.HexToAddress("0x70997970C51812DC3A010C7D01B50E0D17DC79C8"): 1,
+	}, counts)
+}
+
+func TestGetClaimCosts(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 0, decode('CBE7A77275EE22780BB94EA900D42CEF88F5A2F0E1A7C76696556D7FF17767E6','hex'), decode('','hex'), 1, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 1, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 2, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 2, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 3, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 3, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 4, true);
+
+	INSERT INTO sync.monitored_txs
+	(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, gas_used, effective_gas_price, fee)
+	VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-02 03:00:00.000', 100, '10', '1000'),
+	(2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, '0', NULL, 100, 'failed', NULL, '1970-01-01 03:00:00.000', '1970-01-02 05:00:00.000', 50, '20', '1000'),
+	(3, decode('70997970C51812DC3A010C7D01B50E0D17DC79C8','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-03 03:00:00.000', 100, '10', '1000'),
+	(4, decode('70997970C51812DC3A010C7D01B50E0D17DC79C8','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, '0', NULL, 100, 'created', NULL, '1970-01-01 03:00:00.000', '1970-01-03 03:00:00.000', 0, NULL, NULL);
+	`
+	store := createStore(t)
+	ctx := context.Background()
+	_, err := store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	from := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	costs, err := store.GetClaimCosts(ctx, from, from.AddDate(0, 0, 3), nil)
+	require.NoError(t, err)
+	require.Len(t, costs, 2)
+	assert.Equal(t, &ctmtypes.ClaimCost{
+		Day:                from.AddDate(0, 0, 1),
+		DestinationNetwork: 1,
+		Signer:             common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"),
+		Claims:             2,
+		GasUsed:            150,
+		Fee:                big.NewInt(2000),
+	}, costs[0])
+	assert.Equal(t, from.AddDate(0, 0, 2), costs[1].Day)
+	assert.Equal(t, common.HexToAddress("0x70997970C51812DC3A010C7D01B50E0D17DC79C8"), costs[1].Signer)
+	assert.Equal(t, uint64(1), costs[1].Claims)
+
+	// The claims finished out of the time range are not included
+	costs, err = store.GetClaimCosts(ctx, from, from.AddDate(0, 0, 2), nil)
+	require.NoError(t, err)
+	require.Len(t, costs, 1)
+}
+
+func TestClaimPolicyStorage(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, id, ready_for_claim)
+	VALUES(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 0, decode('CBE7A77275EE22780BB94EA900D42CEF88F5A2F0E1A7C76696556D7FF17767E6','hex'), decode('','hex'), 1, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '90000000000000000', 1, decode('70997970C51812DC3A010C7D01B50E0D17DC79C8','hex'), 1, 1, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 2, true),
+	(1, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '0', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 2, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 3, true),
+	(0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '1', 1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, 3, decode('6282FACE883070640F802CE8A2C42593AA18D3A691C61BA006EC477D6E5FEE1F','hex'), decode('','hex'), 4, true);
+
+	INSERT INTO sync.monitored_txs
+	(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, gas_used, effective_gas_price, fee)
+	VALUES(1, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 1, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-02 03:00:00.000', 100, '10', '1000'),
+	(2, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 2, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-02 05:00:00.000', 200, '10', '2000'),
+	(3, decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), decode('F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266','hex'), 3, '0', NULL, 100, 'confirmed', NULL, '1970-01-01 03:00:00.000', '1970-01-02 05:00:00.000', 400, '10', '4000');
+	`
+	store := createStore(t)
+	ctx := context.Background()
+	_, err := store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	day := time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)
+	// The messages are not claims of the ether
+	fee, err := store.GetTokenClaimsFee(ctx, 0, common.Address{}, 1, day, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3000), fee)
+	fee, err = store.GetTokenClaimsFee(ctx, 0, common.Address{}, 1, day.Add(4*time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2000), fee)
+	fee, err = store.GetTokenClaimsFee(ctx, 0, common.Address{}, 2, day, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), fee)
+
+	fee, err = store.GetDestinationClaimsFee(ctx, common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"), 1, day, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5000), fee)
+	fee, err = store.GetDestinationClaimsFee(ctx, common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"), 1, day.AddDate(0, 0, 1), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), fee)
+
+	rejected, err := store.AddRejectedClaim(ctx, 4, "min_amount", "amount 1 is below the minimum 10 of the token", nil)
+	require.NoError(t, err)
+	require.True(t, rejected)
+	rejected, err = store.AddRejectedClaim(ctx, 4, "min_amount", "amount 1 is below the minimum 10 of the token", nil)
+	require.NoError(t, err)
+	require.False(t, rejected)
+	rejected, err = store.AddRejectedClaim(ctx, 4, "token", "token 0x0000000000000000000000000000000000000000 of network 0 is not sponsored", nil)
+	require.NoError(t, err)
+	require.True(t, rejected)
+
+	status, err := store.GetDepositClaimStatus(ctx, 3, 0, nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.RejectedReason)
+	require.Equal(t, "token 0x0000000000000000000000000000000000000000 of network 0 is not sponsored", *status.RejectedReason)
+	require.NotNil(t, status.RejectedAt)
+	status, err = store.GetDepositClaimStatus(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, status.RejectedReason)
+
+	// Only the deposits rejected by the rule before the given time and without a monitored tx are returned
+	_, err = store.AddRejectedClaim(ctx, 1, "budget", "daily budget 1000 of the token is spent", nil)
+	require.NoError(t, err)
+	_, err = store.AddRejectedClaim(ctx, 4, "budget", "daily budget 1000 of the token is spent", nil)
+	require.NoError(t, err)
+	deposits, err := store.GetRejectedL1Deposits(ctx, "budget", 1, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint64(4), deposits[0].Id)
+	deposits, err = store.GetRejectedL1Deposits(ctx, "budget", 1, time.Now().Add(-time.Minute), nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 0)
+	deposits, err = store.GetRejectedL1Deposits(ctx, "token", 1, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 0)
+
+	require.NoError(t, store.DeleteRejectedClaim(ctx, 4, nil))
+	status, err = store.GetDepositClaimStatus(ctx, 3, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, status.RejectedReason)
+}
@@ -1,6 +1,7 @@
 package pgstorage
 
 import (
+	"math/big"
 	"os"
 	"strconv"
 
@@ -126,3 +127,21 @@ func getDecodedToken(metadata []byte) (*etherman.TokenMetadata, error) {
 		Decimals: token["decimals"].(uint8),
 	}, nil
 }
+
+// bigIntToNullString converts an optional big int to the nullable string stored in the DB.
+func bigIntToNullString(i *big.Int) *string {
+	if i == nil {
+		return nil
+	}
+	s := i.String()
+	return &s
+}
+
+// nullStringToBigInt converts a nullable string stored in the DB to an optional big int.
+func nullStringToBigInt(s *string) *big.Int {
+	if s == nil {
+		return nil
+	}
+	i, _ := new(big.Int).SetString(*s, 10) //nolint:gomnd
+	return i
+}
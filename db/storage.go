@@ -1,6 +1,10 @@
 package db
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/fiwallets/zkevm-bridge-service/db/pgstorage"
 	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 )
@@ -36,3 +40,21 @@ func RunMigrations(cfg Config) error {
 	}
 	return pgstorage.RunMigrationsUp(config)
 }
+
+type writerLocker interface {
+	TryAdvisoryLock(ctx context.Context, name string) (*pgstorage.AdvisoryLock, error)
+}
+
+// AcquireWriterLock makes sure that a single process writes the state of a component to the
+// database. It fails if the lock of the component is held by another process.
+func AcquireWriterLock(ctx context.Context, storage Storage, component string) (*pgstorage.AdvisoryLock, error) {
+	locker, ok := storage.(writerLocker)
+	if !ok {
+		return nil, gerror.ErrStorageNotRegister
+	}
+	lock, err := locker.TryAdvisoryLock(ctx, "zkevm-bridge-"+component)
+	if errors.Is(err, gerror.ErrLockNotAvailable) {
+		return nil, fmt.Errorf("another %s component is running against the database: %w", component, err)
+	}
+	return lock, err
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/fiwallets/zkevm-bridge-service/db/pgstorage"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -437,3 +438,31 @@ func TestSetMaxUintNetworkID(t *testing.T) {
 	require.Equal(t, root, rRoot)
 	require.NoError(t, tx.Commit(ctx))
 }
+
+func TestAdvisoryLock(t *testing.T) {
+	cfg := pgstorage.NewConfigFromEnv()
+	// Init database instance
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+	otherPg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+
+	lock, err := pg.TryAdvisoryLock(ctx, "sync")
+	require.NoError(t, err)
+	// The lock is held by another session
+	_, err = otherPg.TryAdvisoryLock(ctx, "sync")
+	require.ErrorIs(t, err, gerror.ErrLockNotAvailable)
+	// Other locks are independent
+	otherLock, err := otherPg.TryAdvisoryLock(ctx, "claimtxman")
+	require.NoError(t, err)
+	require.NoError(t, otherLock.Release(ctx))
+
+	require.NoError(t, lock.Release(ctx))
+	lock, err = otherPg.TryAdvisoryLock(ctx, "sync")
+	require.NoError(t, err)
+	require.NoError(t, lock.Release(ctx))
+}
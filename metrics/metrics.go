@@ -0,0 +1,290 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// Endpoint is the path where the metrics are exposed
+	Endpoint = "/metrics"
+
+	namespace = "zkevm_bridge"
+
+	synchronizerSubsystem   = "synchronizer"
+	claimTxManagerSubsystem = "claimtxman"
+	apiSubsystem            = "api"
+
+	networkIDLabel = "network_id"
+	rollupIDLabel  = "rollup_id"
+	statusLabel    = "status"
+	methodLabel    = "method"
+	codeLabel      = "code"
+	ruleLabel      = "rule"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	syncedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "last_synced_block",
+		Help:      "Latest block stored by the synchronizer",
+	}, []string{networkIDLabel})
+	chainHead = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "chain_head",
+		Help:      "Latest block of the network seen by the synchronizer",
+	}, []string{networkIDLabel})
+	syncLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "lag_blocks",
+		Help:      "Number of blocks the synchronizer is behind the chain head",
+	}, []string{networkIDLabel})
+	reorgs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "reorgs_total",
+		Help:      "Number of reorgs handled by the synchronizer",
+	}, []string{networkIDLabel})
+	deposits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "deposits_total",
+		Help:      "Number of deposits ingested",
+	}, []string{networkIDLabel})
+	claims = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "claims_total",
+		Help:      "Number of claims ingested",
+	}, []string{networkIDLabel})
+	globalExitRoots = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: synchronizerSubsystem,
+		Name:      "global_exit_roots_total",
+		Help:      "Number of global exit roots ingested",
+	}, []string{networkIDLabel})
+
+	monitoredTxs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "monitored_txs",
+		Help:      "Number of monitored claim txs by status",
+	}, []string{rollupIDLabel, statusLabel})
+	groupSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "group_size",
+		Help:      "Number of claims included in each compressed claims group",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 8), //nolint:gomnd
+	}, []string{rollupIDLabel})
+	groupRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "group_retries_total",
+		Help:      "Number of compressed claims txs resent for a group",
+	}, []string{rollupIDLabel})
+	gasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "gas_used_total",
+		Help:      "Gas used by the mined claim txs",
+	}, []string{rollupIDLabel})
+	feeSpent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "fee_spent_wei_total",
+		Help:      "Fees paid in wei by the mined claim txs",
+	}, []string{rollupIDLabel})
+	deferredClaims = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "deferred_claims_total",
+		Help:      "Number of times a claim tx was not sent because its fees exceeded the caps",
+	}, []string{rollupIDLabel})
+	replacedTxs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "replaced_txs_total",
+		Help:      "Number of pending claim txs replaced by a tx with bumped fees",
+	}, []string{rollupIDLabel})
+	nonceGaps = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "nonce_gaps_filled_total",
+		Help:      "Number of nonces of abandoned claims filled with no-op txs",
+	}, []string{rollupIDLabel})
+
+	claimedExternally = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "claimed_externally_total",
+		Help:      "Number of deposits found claimed on-chain by another tx before sending their claim",
+	}, []string{rollupIDLabel})
+	rejectedClaims = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: claimTxManagerSubsystem,
+		Name:      "rejected_claims_total",
+		Help:      "Number of deposits not claimed because of the claim policies, by the rule that rejected them",
+	}, []string{rollupIDLabel, ruleLabel})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: apiSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the API requests",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{methodLabel, codeLabel})
+	apiRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: apiSubsystem,
+		Name:      "request_errors_total",
+		Help:      "Number of API requests that returned an error",
+	}, []string{methodLabel, codeLabel})
+
+	// progress keeps the latest synced block and chain head of every network to compute the lag
+	progressMutex sync.Mutex
+	progress      = make(map[uint32]*syncProgress)
+)
+
+type syncProgress struct {
+	syncedBlock uint64
+	chainHead   uint64
+}
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		syncedBlock, chainHead, syncLag, reorgs, deposits, claims, globalExitRoots,
+		monitoredTxs, groupSize, groupRetries, gasUsed, feeSpent, deferredClaims, replacedTxs, nonceGaps, claimedExternally,
+		rejectedClaims,
+		apiRequestDuration, apiRequestErrors,
+	)
+}
+
+// Handler returns the http handler that exposes the metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// SyncedBlock sets the latest block stored by the synchronizer of a network.
+func SyncedBlock(networkID uint32, blockNumber uint64) {
+	syncedBlock.WithLabelValues(label(networkID)).Set(float64(blockNumber))
+	updateProgress(networkID, func(p *syncProgress) { p.syncedBlock = blockNumber })
+}
+
+// ChainHead sets the latest block of a network seen by its synchronizer.
+func ChainHead(networkID uint32, blockNumber uint64) {
+	chainHead.WithLabelValues(label(networkID)).Set(float64(blockNumber))
+	updateProgress(networkID, func(p *syncProgress) { p.chainHead = blockNumber })
+}
+
+// Reorg counts a reorg handled by the synchronizer of a network.
+func Reorg(networkID uint32) {
+	reorgs.WithLabelValues(label(networkID)).Inc()
+}
+
+// DepositsIngested counts the deposits stored by the synchronizer of a network.
+func DepositsIngested(networkID uint32, count int) {
+	deposits.WithLabelValues(label(networkID)).Add(float64(count))
+}
+
+// ClaimsIngested counts the claims stored by the synchronizer of a network.
+func ClaimsIngested(networkID uint32, count int) {
+	claims.WithLabelValues(label(networkID)).Add(float64(count))
+}
+
+// GlobalExitRootsIngested counts the global exit roots stored by the synchronizer of a network.
+func GlobalExitRootsIngested(networkID uint32, count int) {
+	globalExitRoots.WithLabelValues(label(networkID)).Add(float64(count))
+}
+
+// MonitoredTxs sets the number of monitored claim txs of a rollup in a status.
+func MonitoredTxs(rollupID uint32, status string, count uint64) {
+	monitoredTxs.WithLabelValues(label(rollupID), status).Set(float64(count))
+}
+
+// ClaimGroupCreated observes the size of a new compressed claims group of a rollup.
+func ClaimGroupCreated(rollupID uint32, size int) {
+	groupSize.WithLabelValues(label(rollupID)).Observe(float64(size))
+}
+
+// ClaimGroupRetry counts a compressed claims tx resent for a group of a rollup.
+func ClaimGroupRetry(rollupID uint32) {
+	groupRetries.WithLabelValues(label(rollupID)).Inc()
+}
+
+// ClaimTxMined counts the gas used and the fee paid by a mined claim tx of a rollup. The
+// effective gas price can be nil if the node doesn't return it in the receipt.
+func ClaimTxMined(rollupID uint32, gas uint64, effectiveGasPrice *big.Int) {
+	gasUsed.WithLabelValues(label(rollupID)).Add(float64(gas))
+	if effectiveGasPrice != nil {
+		fee, _ := new(big.Float).SetInt(new(big.Int).Mul(effectiveGasPrice, new(big.Int).SetUint64(gas))).Float64()
+		feeSpent.WithLabelValues(label(rollupID)).Add(fee)
+	}
+}
+
+// ClaimTxDeferred counts a claim tx of a rollup not sent because its fees exceeded the caps.
+func ClaimTxDeferred(rollupID uint32) {
+	deferredClaims.WithLabelValues(label(rollupID)).Inc()
+}
+
+// ClaimTxReplaced counts a pending claim tx of a rollup replaced by a tx with bumped fees.
+func ClaimTxReplaced(rollupID uint32) {
+	replacedTxs.WithLabelValues(label(rollupID)).Inc()
+}
+
+// NonceGapFilled counts a nonce of an abandoned claim of a rollup filled with a no-op tx.
+func NonceGapFilled(rollupID uint32) {
+	nonceGaps.WithLabelValues(label(rollupID)).Inc()
+}
+
+// ClaimedExternally counts a deposit of a rollup found claimed on-chain by another tx before sending its claim.
+func ClaimedExternally(rollupID uint32) {
+	claimedExternally.WithLabelValues(label(rollupID)).Inc()
+}
+
+// ClaimRejected counts a deposit of a rollup not claimed because of the claim policy rule.
+func ClaimRejected(rollupID uint32, rule string) {
+	rejectedClaims.WithLabelValues(label(rollupID), rule).Inc()
+}
+
+// APIRequest observes the latency of an API request and counts it as an error if the code is not OK.
+func APIRequest(method, code string, duration time.Duration) {
+	apiRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+	if code != "OK" {
+		apiRequestErrors.WithLabelValues(method, code).Inc()
+	}
+}
+
+func updateProgress(networkID uint32, f func(p *syncProgress)) {
+	progressMutex.Lock()
+	defer progressMutex.Unlock()
+	p, ok := progress[networkID]
+	if !ok {
+		p = &syncProgress{}
+		progress[networkID] = p
+	}
+	f(p)
+	var lag uint64
+	if p.chainHead > p.syncedBlock {
+		lag = p.chainHead - p.syncedBlock
+	}
+	syncLag.WithLabelValues(label(networkID)).Set(float64(lag))
+}
+
+func label(id uint32) string {
+	return strconv.FormatUint(uint64(id), 10) //nolint:gomnd
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncLag(t *testing.T) {
+	ChainHead(100, 120)
+	SyncedBlock(100, 100)
+	require.Equal(t, float64(20), testutil.ToFloat64(syncLag.WithLabelValues("100")))
+	SyncedBlock(100, 120)
+	require.Equal(t, float64(0), testutil.ToFloat64(syncLag.WithLabelValues("100")))
+	// The chain head can be outdated after syncing new blocks
+	SyncedBlock(100, 125)
+	require.Equal(t, float64(0), testutil.ToFloat64(syncLag.WithLabelValues("100")))
+	require.Equal(t, float64(125), testutil.ToFloat64(syncedBlock.WithLabelValues("100")))
+}
+
+func TestClaimTxMined(t *testing.T) {
+	ClaimTxMined(100, 21000, big.NewInt(2))
+	ClaimTxMined(100, 1000, nil)
+	require.Equal(t, float64(22000), testutil.ToFloat64(gasUsed.WithLabelValues("100")))
+	require.Equal(t, float64(42000), testutil.ToFloat64(feeSpent.WithLabelValues("100")))
+}
+
+func TestClaimTxDeferred(t *testing.T) {
+	ClaimTxDeferred(100)
+	ClaimTxDeferred(100)
+	require.Equal(t, float64(2), testutil.ToFloat64(deferredClaims.WithLabelValues("100")))
+}
+
+func TestClaimTxReplaced(t *testing.T) {
+	ClaimTxReplaced(100)
+	require.Equal(t, float64(1), testutil.ToFloat64(replacedTxs.WithLabelValues("100")))
+}
+
+func TestNonceGapFilled(t *testing.T) {
+	NonceGapFilled(100)
+	require.Equal(t, float64(1), testutil.ToFloat64(nonceGaps.WithLabelValues("100")))
+}
+
+func TestClaimedExternally(t *testing.T) {
+	ClaimedExternally(100)
+	require.Equal(t, float64(1), testutil.ToFloat64(claimedExternally.WithLabelValues("100")))
+}
+
+func TestClaimRejected(t *testing.T) {
+	ClaimRejected(100, "budget")
+	require.Equal(t, float64(1), testutil.ToFloat64(rejectedClaims.WithLabelValues("100", "budget")))
+}
+
+func TestAPIRequest(t *testing.T) {
+	APIRequest("TestMethod", "OK", time.Millisecond)
+	APIRequest("TestMethod", "NotFound", time.Millisecond)
+	require.Equal(t, float64(0), testutil.ToFloat64(apiRequestErrors.WithLabelValues("TestMethod", "OK")))
+	require.Equal(t, float64(1), testutil.ToFloat64(apiRequestErrors.WithLabelValues("TestMethod", "NotFound")))
+	require.Equal(t, 2, testutil.CollectAndCount(apiRequestDuration, "zkevm_bridge_api_request_duration_seconds"))
+}
+
+func TestHandler(t *testing.T) {
+	DepositsIngested(100, 3)
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Endpoint, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, strings.Contains(rec.Body.String(), `zkevm_bridge_synchronizer_deposits_total{network_id="100"} 3`))
+}
@@ -0,0 +1,16 @@
+package remotesigner
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/go-ethereum/common"
+)
+
+// Config represents the configuration of a remote signer
+type Config struct {
+	// URL is the HTTP JSON-RPC endpoint of the signer, which must support eth_signTransaction
+	URL string `mapstructure:"URL"`
+	// Address is the account of the signer used to sign the txs
+	Address common.Address `mapstructure:"Address"`
+	// Timeout is the timeout of the signing requests. 10s when it is not set
+	Timeout types.Duration `mapstructure:"Timeout"`
+}
@@ -0,0 +1,154 @@
+package remotesigner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/go-ethereum/common/hexutil"
+	"github.com/fiwallets/go-ethereum/core/types"
+	"github.com/fiwallets/go-ethereum/rpc"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Signer signs the txs of an account with a remote signer through the eth_signTransaction
+// JSON-RPC method, so the private key is never loaded by the service.
+type Signer struct {
+	client  *rpc.Client
+	address common.Address
+	signer  types.Signer
+	timeout time.Duration
+}
+
+// SignTxArgs are the arguments of the eth_signTransaction request.
+type SignTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+// New creates a remote signer for the chain.
+func New(ctx context.Context, cfg Config, chainID *big.Int) (*Signer, error) {
+	if !strings.HasPrefix(cfg.URL, "http://") && !strings.HasPrefix(cfg.URL, "https://") {
+		return nil, fmt.Errorf("invalid remote signer URL '%s'", cfg.URL)
+	}
+	if cfg.Address == (common.Address{}) {
+		return nil, errors.New("the address of the remote signer is required")
+	}
+	timeout := cfg.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	client, err := rpc.DialOptions(ctx, cfg.URL, rpc.WithHTTPClient(&http.Client{Timeout: timeout}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the remote signer %s: %v", cfg.URL, err)
+	}
+	return &Signer{
+		client:  client,
+		address: cfg.Address,
+		signer:  types.LatestSignerForChainID(chainID),
+		timeout: timeout,
+	}, nil
+}
+
+// NewTransactOpts creates the tx options of the account of a remote signer.
+func NewTransactOpts(ctx context.Context, cfg Config, chainID *big.Int) (*bind.TransactOpts, error) {
+	s, err := New(ctx, cfg, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return &bind.TransactOpts{
+		From:    s.address,
+		Signer:  s.SignTx,
+		Context: context.Background(),
+	}, nil
+}
+
+// Address returns the account of the signer.
+func (s *Signer) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs the tx with the remote signer. It is a bind.SignerFn. The signed tx is checked to
+// be the requested one signed by the account.
+func (s *Signer) SignTx(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if addr != s.address {
+		return nil, bind.ErrNotAuthorized
+	}
+	args := SignTxArgs{
+		From:    addr,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(s.signer.ChainID()),
+	}
+	if args.Value == nil {
+		args.Value = new(hexutil.Big)
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("failed to sign the tx with the remote signer: %v", err)
+	}
+	signedTx, err := decodeSignedTx(result)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := types.Sender(s.signer, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature of the remote signer: %v", err)
+	}
+	if sender != addr {
+		return nil, fmt.Errorf("the remote signer signed the tx with %s instead of %s", sender.String(), addr.String())
+	}
+	if s.signer.Hash(signedTx) != s.signer.Hash(tx) {
+		return nil, fmt.Errorf("the remote signer signed a tx different from the requested one")
+	}
+	return signedTx, nil
+}
+
+// decodeSignedTx decodes the result of eth_signTransaction, which is the raw signed tx or, like
+// the nodes return it, an object with the raw signed tx.
+func decodeSignedTx(result json.RawMessage) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var obj struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(result, &obj); err != nil || len(obj.Raw) == 0 {
+			return nil, fmt.Errorf("invalid response of the remote signer: %s", string(result))
+		}
+		raw = obj.Raw
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode the tx signed by the remote signer: %v", err)
+	}
+	return signedTx, nil
+}
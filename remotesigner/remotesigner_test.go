@@ -0,0 +1,143 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/go-ethereum/accounts/abi/bind"
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/fiwallets/go-ethereum/common/hexutil"
+	ethtypes "github.com/fiwallets/go-ethereum/core/types"
+	"github.com/fiwallets/go-ethereum/crypto"
+	"github.com/fiwallets/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// standInSigner is a local remote signer serving eth_signTransaction.
+type standInSigner struct {
+	chainID *big.Int
+
+	mu         sync.Mutex
+	key        *ecdsa.PrivateKey
+	rawOnly    bool
+	tamperWith func(tx *ethtypes.DynamicFeeTx)
+}
+
+// SignTransaction serves eth_signTransaction.
+func (s *standInSigner) SignTransaction(args SignTxArgs) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var txData ethtypes.TxData
+	if args.MaxFeePerGas != nil {
+		dynamicTx := &ethtypes.DynamicFeeTx{
+			ChainID: args.ChainID.ToInt(), Nonce: uint64(args.Nonce), GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data,
+		}
+		if s.tamperWith != nil {
+			s.tamperWith(dynamicTx)
+		}
+		txData = dynamicTx
+	} else {
+		txData = &ethtypes.LegacyTx{
+			Nonce: uint64(args.Nonce), GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To,
+			Value: args.Value.ToInt(), Data: args.Data,
+		}
+	}
+	signedTx, err := ethtypes.SignNewTx(s.key, ethtypes.LatestSignerForChainID(s.chainID), txData)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s.rawOnly {
+		return hexutil.Bytes(raw), nil
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signedTx}, nil
+}
+
+func newStandInSigner(t *testing.T, chainID *big.Int) (*standInSigner, string) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	standIn := &standInSigner{chainID: chainID, key: key}
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", standIn))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+	return standIn, httpSrv.URL
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(1001)
+	_, err := New(ctx, Config{URL: "localhost:9000", Address: common.HexToAddress("0x1")}, chainID)
+	require.Error(t, err)
+	_, err = New(ctx, Config{URL: "http://localhost:9000"}, chainID)
+	require.Error(t, err)
+	s, err := New(ctx, Config{URL: "http://localhost:9000", Address: common.HexToAddress("0x1")}, chainID)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x1"), s.Address())
+	require.Equal(t, defaultTimeout, s.timeout)
+}
+
+func TestSignTx(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(1001)
+	standIn, url := newStandInSigner(t, chainID)
+	addr := crypto.PubkeyToAddress(standIn.key.PublicKey)
+	auth, err := NewTransactOpts(ctx, Config{URL: url, Address: addr, Timeout: types.NewDuration(time.Second)}, chainID)
+	require.NoError(t, err)
+	require.Equal(t, addr, auth.From)
+	to := common.HexToAddress("0x2")
+
+	// EIP-1559 tx
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(5)})
+	signedTx, err := auth.Signer(addr, tx)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.LatestSignerForChainID(chainID).Hash(tx), ethtypes.LatestSignerForChainID(chainID).Hash(signedTx))
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), signedTx)
+	require.NoError(t, err)
+	require.Equal(t, addr, sender)
+
+	// Legacy tx answered with the raw signed tx
+	standIn.mu.Lock()
+	standIn.rawOnly = true
+	standIn.mu.Unlock()
+	tx = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(10), Gas: 100000, To: &to, Data: []byte{0xca, 0xfe}})
+	signedTx, err = auth.Signer(addr, tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Nonce(), signedTx.Nonce())
+	require.Equal(t, tx.Data(), signedTx.Data())
+
+	// Other accounts are not signed
+	_, err = auth.Signer(to, tx)
+	require.ErrorIs(t, err, bind.ErrNotAuthorized)
+
+	// The tx signed must be the requested one
+	standIn.mu.Lock()
+	standIn.tamperWith = func(tx *ethtypes.DynamicFeeTx) { tx.To = &addr }
+	standIn.mu.Unlock()
+	tx = ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 5, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to})
+	_, err = auth.Signer(addr, tx)
+	require.Error(t, err)
+
+	// The tx must be signed by the account
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	standIn.mu.Lock()
+	standIn.tamperWith = nil
+	standIn.key = otherKey
+	standIn.mu.Unlock()
+	_, err = auth.Signer(addr, tx)
+	require.Error(t, err)
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+
+	// gatewayTokenHeader authenticates the requests forwarded by the HTTP/REST gateway, which
+	// carry the identity of the HTTP client in the gatewayClientIPHeader and apiKeyHeader
+	gatewayTokenHeader    = "x-bridge-gateway-token"
+	gatewayClientIPHeader = "x-bridge-client-ip"
+
+	healthServicePrefix = "/grpc.health.v1.Health/"
+
+	// bucketIdleTimeout is the time after which the bucket of an inactive client is released
+	bucketIdleTimeout = 10 * time.Minute
+	gatewayTokenLen   = 32
+)
+
+// clientIdentity identifies the client of an API request
+type clientIdentity struct {
+	ip     string
+	apiKey string
+}
+
+type clientIdentityKey struct{}
+
+// accessController authenticates the API requests and applies the rate limits.
+type accessController struct {
+	authEnabled  bool
+	apiKeys      map[string]struct{}
+	limiter      *rateLimiter
+	gatewayToken string
+}
+
+// newAccessController validates the config and creates the access controller of the API.
+func newAccessController(auth AuthConfig, rateLimit RateLimitConfig) (*accessController, error) {
+	if auth.Enabled && len(auth.APIKeys) == 0 {
+		return nil, errors.New("the API authentication is enabled but no API keys are configured")
+	}
+	token := make([]byte, gatewayTokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("error generating the gateway token: %w", err)
+	}
+	a := &accessController{
+		authEnabled:  auth.Enabled,
+		apiKeys:      make(map[string]struct{}, len(auth.APIKeys)),
+		gatewayToken: hex.EncodeToString(token),
+	}
+	for _, key := range auth.APIKeys {
+		a.apiKeys[key] = struct{}{}
+	}
+	if rateLimit.Enabled {
+		for name, limit := range map[string]RateLimit{"Default": rateLimit.Default, "Expensive": rateLimit.Expensive} {
+			if limit.RequestsPerSecond <= 0 || limit.Burst <= 0 {
+				return nil, fmt.Errorf("invalid %s rate limit: %+v", name, limit)
+			}
+		}
+		a.limiter = newRateLimiter(rateLimit)
+	}
+	return a, nil
+}
+
+// unaryInterceptor rejects the requests without a valid API key with Unauthenticated and the
+// requests over the rate limit of the client with ResourceExhausted. The gateway translates
+// them to 401 and 429 HTTP responses.
+func (a *accessController) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+	client := a.grpcClientIdentity(ctx)
+	limitKey := client.ip
+	if a.authEnabled {
+		if _, ok := a.apiKeys[client.apiKey]; !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid API key")
+		}
+		limitKey = client.apiKey
+	}
+	if a.limiter != nil && !a.limiter.allow(path.Base(info.FullMethod), limitKey) {
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return handler(ctx, req)
+}
+
+// grpcClientIdentity returns the identity of the client of a gRPC request. The requests forwarded
+// by the gateway are identified by the HTTP client instead of the gateway connection.
+func (a *accessController) grpcClientIdentity(ctx context.Context) clientIdentity {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := firstMetadataValue(md, gatewayTokenHeader); token != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(a.gatewayToken)) == 1 {
+		return clientIdentity{
+			ip:     firstMetadataValue(md, gatewayClientIPHeader),
+			apiKey: firstMetadataValue(md, apiKeyHeader),
+		}
+	}
+	var client clientIdentity
+	if p, ok := peer.FromContext(ctx); ok {
+		client.ip = hostFromAddr(p.Addr.String())
+	}
+	client.apiKey = firstMetadataValue(md, apiKeyHeader)
+	if client.apiKey == "" {
+		client.apiKey = bearerToken(firstMetadataValue(md, authorizationHeader))
+	}
+	return client
+}
+
+// httpMiddleware stores the identity of the HTTP client in the request context, so the gateway
+// can forward it to the gRPC server.
+func (a *accessController) httpMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := clientIdentity{
+			ip:     hostFromAddr(r.RemoteAddr),
+			apiKey: r.Header.Get(apiKeyHeader),
+		}
+		if client.apiKey == "" {
+			client.apiKey = bearerToken(r.Header.Get(authorizationHeader))
+		}
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIdentityKey{}, client)))
+	})
+}
+
+// gatewayUnaryInterceptor forwards the identity of the HTTP client to the gRPC server. The values
+// set by the HTTP client for the same metadata keys are overwritten.
+func (a *accessController) gatewayUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	client, _ := ctx.Value(clientIdentityKey{}).(clientIdentity)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(gatewayTokenHeader, a.gatewayToken)
+	md.Set(gatewayClientIPHeader, client.ip)
+	md.Set(apiKeyHeader, client.apiKey)
+	return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func bearerToken(authorization string) string {
+	if len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(authorization[len(bearerPrefix):])
+	}
+	return ""
+}
+
+func hostFromAddr(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// rateLimiter keeps a token bucket per client and method class.
+type rateLimiter struct {
+	defaultLimit     RateLimit
+	expensiveLimit   RateLimit
+	expensiveMethods map[string]struct{}
+
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	l := &rateLimiter{
+		defaultLimit:     cfg.Default,
+		expensiveLimit:   cfg.Expensive,
+		expensiveMethods: make(map[string]struct{}, len(cfg.ExpensiveMethods)),
+		buckets:          make(map[string]*bucket),
+		lastCleanup:      time.Now(),
+	}
+	for _, method := range cfg.ExpensiveMethods {
+		l.expensiveMethods[method] = struct{}{}
+	}
+	return l
+}
+
+// allow consumes a token of the bucket of the client for the class of the method.
+func (l *rateLimiter) allow(method, client string) bool {
+	class, limit := "default", l.defaultLimit
+	if _, ok := l.expensiveMethods[method]; ok {
+		class, limit = "expensive", l.expensiveLimit
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastCleanup) > bucketIdleTimeout {
+		for key, b := range l.buckets {
+			if now.Sub(b.lastSeen) > bucketIdleTimeout {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+	key := class + "/" + client
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, 1)
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testMethod          = "/bridge.v1.BridgeService/GetBridges"
+	testExpensiveMethod = "/bridge.v1.BridgeService/GetProof"
+)
+
+func newTestRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enabled:          true,
+		Default:          RateLimit{RequestsPerSecond: 0.001, Burst: 2},
+		Expensive:        RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+		ExpensiveMethods: []string{"GetProof"},
+	}
+}
+
+// callAccessInterceptor calls the access interceptor of the method with a request from the ip and the metadata.
+func callAccessInterceptor(a *accessController, method, ip string, md metadata.MD) error {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	ctx = metadata.NewIncomingContext(ctx, md)
+	_, err := a.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestNewAccessController(t *testing.T) {
+	_, err := newAccessController(AuthConfig{Enabled: true}, RateLimitConfig{})
+	require.Error(t, err)
+	cfg := newTestRateLimitConfig()
+	cfg.Expensive.Burst = 0
+	_, err = newAccessController(AuthConfig{}, cfg)
+	require.Error(t, err)
+	// Limits are not validated when disabled
+	cfg.Enabled = false
+	a, err := newAccessController(AuthConfig{}, cfg)
+	require.NoError(t, err)
+	require.Nil(t, a.limiter)
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.1", nil))
+}
+
+func TestAccessAuthentication(t *testing.T) {
+	a, err := newAccessController(AuthConfig{Enabled: true, APIKeys: []string{"key1", "key2"}}, RateLimitConfig{})
+	require.NoError(t, err)
+
+	err = callAccessInterceptor(a, testMethod, "10.0.0.1", nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = callAccessInterceptor(a, testMethod, "10.0.0.1", metadata.Pairs(apiKeyHeader, "wrong"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = callAccessInterceptor(a, testMethod, "10.0.0.1", metadata.Pairs(authorizationHeader, "Basic key1"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.1", metadata.Pairs(apiKeyHeader, "key1")))
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.1", metadata.Pairs(authorizationHeader, "Bearer key2")))
+	// Health checks are public
+	require.NoError(t, callAccessInterceptor(a, healthServicePrefix+"Check", "10.0.0.1", nil))
+}
+
+func TestAccessRateLimit(t *testing.T) {
+	a, err := newAccessController(AuthConfig{}, newTestRateLimitConfig())
+	require.NoError(t, err)
+
+	// Default class: burst of 2 per IP
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.1", nil))
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.1", nil))
+	err = callAccessInterceptor(a, testMethod, "10.0.0.1", nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, http.StatusTooManyRequests, runtime.HTTPStatusFromCode(status.Code(err)))
+	require.NoError(t, callAccessInterceptor(a, testMethod, "10.0.0.2", nil))
+
+	// Expensive class has its own bucket
+	require.NoError(t, callAccessInterceptor(a, testExpensiveMethod, "10.0.0.1", nil))
+	err = callAccessInterceptor(a, testExpensiveMethod, "10.0.0.1", nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Health checks are not limited
+	for i := 0; i < 5; i++ {
+		require.NoError(t, callAccessInterceptor(a, healthServicePrefix+"Check", "10.0.0.1", nil))
+	}
+
+	// With authentication the requests are limited per API key instead of per IP
+	a, err = newAccessController(AuthConfig{Enabled: true, APIKeys: []string{"key1", "key2"}}, newTestRateLimitConfig())
+	require.NoError(t, err)
+	require.NoError(t, callAccessInterceptor(a, testExpensiveMethod, "10.0.0.1", metadata.Pairs(apiKeyHeader, "key1")))
+	err = callAccessInterceptor(a, testExpensiveMethod, "10.0.0.2", metadata.Pairs(apiKeyHeader, "key1"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, callAccessInterceptor(a, testExpensiveMethod, "10.0.0.1", metadata.Pairs(apiKeyHeader, "key2")))
+}
+
+func TestAccessGatewayIdentity(t *testing.T) {
+	a, err := newAccessController(AuthConfig{Enabled: true, APIKeys: []string{"key1"}}, newTestRateLimitConfig())
+	require.NoError(t, err)
+
+	// The gateway forwards the identity of the HTTP client to the gRPC server
+	var grpcErr error
+	var forwarded clientIdentity
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		forwarded = a.grpcClientIdentity(metadata.NewIncomingContext(context.Background(), md))
+		grpcErr = callAccessInterceptor(a, method, "127.0.0.1", md)
+		return grpcErr
+	}
+	handler := a.httpMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Values sent by the HTTP client as gRPC metadata can't impersonate other clients
+		ctx := metadata.AppendToOutgoingContext(r.Context(), gatewayClientIPHeader, "10.9.9.9", apiKeyHeader, "key1")
+		_ = a.gatewayUnaryInterceptor(ctx, testExpensiveMethod, nil, nil, nil, invoker)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/merkle-proof", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, clientIdentity{ip: "10.0.0.1"}, forwarded)
+	require.Equal(t, codes.Unauthenticated, status.Code(grpcErr))
+
+	req = httptest.NewRequest(http.MethodGet, "/merkle-proof", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	req.Header.Set("Authorization", "Bearer key1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, clientIdentity{ip: "10.0.0.1", apiKey: "key1"}, forwarded)
+	require.NoError(t, grpcErr)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, codes.ResourceExhausted, status.Code(grpcErr))
+
+	// Direct gRPC clients can't use the gateway headers without the gateway token
+	md := metadata.Pairs(gatewayTokenHeader, "wrong", gatewayClientIPHeader, "10.9.9.9", apiKeyHeader, "key1")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.3"), Port: 1234}})
+	require.Equal(t, clientIdentity{ip: "10.0.0.3", apiKey: "key1"}, a.grpcClientIdentity(metadata.NewIncomingContext(ctx, md)))
+}
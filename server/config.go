@@ -1,6 +1,9 @@
 package server
 
-import "github.com/fiwallets/zkevm-bridge-service/db"
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/zkevm-bridge-service/db"
+)
 
 // Config struct
 type Config struct {
@@ -18,4 +21,109 @@ type Config struct {
 	BridgeVersion string `mapstructure:"BridgeVersion"`
 	// DB is the database config
 	DB db.Config `mapstructure:"DB"`
+	// Health is the configuration of the health checks
+	Health HealthConfig `mapstructure:"Health"`
+	// TLS is the TLS configuration of the gRPC server and the HTTP/REST gateway
+	TLS TLSConfig `mapstructure:"TLS"`
+	// Auth is the configuration of the API authentication
+	Auth AuthConfig `mapstructure:"Auth"`
+	// RateLimit is the configuration of the API rate limits
+	RateLimit RateLimitConfig `mapstructure:"RateLimit"`
+	// CORS is the Cross Origin Resource Sharing policy of the HTTP/REST gateway
+	CORS CORSConfig `mapstructure:"CORS"`
+	// OpenAPI is the configuration of the OpenAPI document and the API viewer served by the HTTP/REST gateway
+	OpenAPI OpenAPIConfig `mapstructure:"OpenAPI"`
+	// EnableReflection registers the gRPC server reflection service, so clients like grpcurl can call
+	// the API without the proto files. The reflection service doesn't require an API key
+	EnableReflection bool `mapstructure:"EnableReflection"`
+}
+
+// HealthConfig is the configuration of the health checks
+type HealthConfig struct {
+	// CheckInterval is the interval between health status evaluations
+	CheckInterval types.Duration `mapstructure:"CheckInterval"`
+	// MaxSyncLag is the maximum number of blocks a synchronizer can be behind the chain head to be healthy
+	MaxSyncLag uint64 `mapstructure:"MaxSyncLag"`
+	// MaxSyncStatusAge is the maximum time without a synchronizer reporting its status to be healthy
+	MaxSyncStatusAge types.Duration `mapstructure:"MaxSyncStatusAge"`
+	// MaxClaimTxManagerInactivity is the maximum time without a claim tx manager monitoring its txs to be healthy
+	MaxClaimTxManagerInactivity types.Duration `mapstructure:"MaxClaimTxManagerInactivity"`
+}
+
+// TLSConfig is the TLS configuration of the gRPC server and the HTTP/REST gateway
+type TLSConfig struct {
+	// Enabled serves both the gRPC and the HTTP/REST APIs over TLS
+	Enabled bool `mapstructure:"Enabled"`
+	// CertFile is the path of the PEM encoded certificate of the servers
+	CertFile string `mapstructure:"CertFile"`
+	// KeyFile is the path of the PEM encoded private key of the servers
+	KeyFile string `mapstructure:"KeyFile"`
+	// ClientCAFile is the path of the PEM encoded CAs used to verify the client certificates of the
+	// gRPC server (mutual TLS). Client certificates are not requested when empty
+	ClientCAFile string `mapstructure:"ClientCAFile"`
+	// GatewayCAFile is the path of the PEM encoded CAs used by the HTTP/REST gateway to verify the
+	// gRPC server certificate. The system CAs are used when empty
+	GatewayCAFile string `mapstructure:"GatewayCAFile"`
+	// GatewayServerName is the name verified by the HTTP/REST gateway in the gRPC server certificate
+	GatewayServerName string `mapstructure:"GatewayServerName"`
+	// GatewayCertFile is the path of the PEM encoded client certificate presented by the HTTP/REST
+	// gateway to the gRPC server when mutual TLS is enabled. The server certificate is used when empty
+	GatewayCertFile string `mapstructure:"GatewayCertFile"`
+	// GatewayKeyFile is the path of the PEM encoded private key of the gateway client certificate
+	GatewayKeyFile string `mapstructure:"GatewayKeyFile"`
+}
+
+// AuthConfig is the configuration of the API authentication
+type AuthConfig struct {
+	// Enabled requires a valid API key in every API request. The health checks are always public
+	Enabled bool `mapstructure:"Enabled"`
+	// APIKeys are the accepted keys, sent in the x-api-key header or as bearer tokens in the authorization header
+	APIKeys []string `mapstructure:"APIKeys"`
+}
+
+// RateLimitConfig is the configuration of the API rate limits. The requests are limited per API key,
+// or per IP when the authentication is disabled, and per method class.
+type RateLimitConfig struct {
+	// Enabled is the flag to enable/disable the rate limits
+	Enabled bool `mapstructure:"Enabled"`
+	// Default is the limit of the methods not listed in ExpensiveMethods
+	Default RateLimit `mapstructure:"Default"`
+	// Expensive is the limit of the methods listed in ExpensiveMethods
+	Expensive RateLimit `mapstructure:"Expensive"`
+	// ExpensiveMethods are the names of the API methods limited by the Expensive limit
+	ExpensiveMethods []string `mapstructure:"ExpensiveMethods"`
+}
+
+// RateLimit is a token bucket limit
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled
+	RequestsPerSecond float64 `mapstructure:"RequestsPerSecond"`
+	// Burst is the size of the bucket, the maximum number of requests allowed at once
+	Burst int `mapstructure:"Burst"`
+}
+
+// CORSConfig is the Cross Origin Resource Sharing policy of the HTTP/REST gateway. The requests
+// from origins not allowed are rejected.
+type CORSConfig struct {
+	// AllowedOrigins are the origins allowed to call the API, like "https://bridge.example.com".
+	// Wildcards are allowed, like "https://*.example.com", and "*" allows any origin
+	AllowedOrigins []string `mapstructure:"AllowedOrigins"`
+	// AllowedMethods are the HTTP methods allowed in the cross origin requests
+	AllowedMethods []string `mapstructure:"AllowedMethods"`
+	// AllowedHeaders are the headers allowed in the cross origin requests
+	AllowedHeaders []string `mapstructure:"AllowedHeaders"`
+	// AllowCredentials allows the cross origin requests to include credentials, like cookies
+	AllowCredentials bool `mapstructure:"AllowCredentials"`
+	// MaxAge is how long the browsers can cache the result of a preflight request
+	MaxAge types.Duration `mapstructure:"MaxAge"`
+}
+
+// OpenAPIConfig is the configuration of the OpenAPI document and the API viewer
+type OpenAPIConfig struct {
+	// Enabled serves the OpenAPI document and the API viewer
+	Enabled bool `mapstructure:"Enabled"`
+	// SpecPath is the HTTP path of the OpenAPI document, like "/openapi.json"
+	SpecPath string `mapstructure:"SpecPath"`
+	// UIPath is the HTTP path of the Swagger UI viewer of the OpenAPI document, like "/swagger-ui"
+	UIPath string `mapstructure:"UIPath"`
 }
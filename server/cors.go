@@ -0,0 +1,72 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const anyOrigin = "*"
+
+// corsPolicy applies the Cross Origin Resource Sharing policy of the HTTP/REST gateway.
+type corsPolicy struct {
+	cfg            CORSConfig
+	allowedMethods string
+	allowedHeaders string
+}
+
+func newCORSPolicy(cfg CORSConfig) *corsPolicy {
+	return &corsPolicy{
+		cfg:            cfg,
+		allowedMethods: strings.Join(cfg.AllowedMethods, ","),
+		allowedHeaders: strings.Join(cfg.AllowedHeaders, ","),
+	}
+}
+
+// isOriginAllowed checks the origin against the allowed origins, which can contain a wildcard.
+func (c *corsPolicy) isOriginAllowed(origin string) bool {
+	for _, allowed := range c.cfg.AllowedOrigins {
+		if allowed == anyOrigin || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		if prefix, suffix, ok := strings.Cut(allowed, "*"); ok {
+			lowerOrigin := strings.ToLower(origin)
+			if len(lowerOrigin) > len(prefix)+len(suffix) &&
+				strings.HasPrefix(lowerOrigin, strings.ToLower(prefix)) && strings.HasSuffix(lowerOrigin, strings.ToLower(suffix)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// handler rejects the cross origin requests from origins not allowed and adds the CORS headers to
+// the allowed ones. The preflight requests are answered without reaching the API.
+func (c *corsPolicy) handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		if !c.isOriginAllowed(origin) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if c.cfg.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", c.allowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", c.allowedHeaders)
+			if c.cfg.MaxAge.Duration > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(c.cfg.MaxAge.Seconds())))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCORS(cfg CORSConfig, method, origin string, preflight bool) *httptest.ResponseRecorder {
+	handler := newCORSPolicy(cfg).handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(method, "/bridges/0x0", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	if preflight {
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCORSOrigins(t *testing.T) {
+	cfg := CORSConfig{AllowedOrigins: []string{"https://bridge.example.com", "https://*.example.org"}}
+	policy := newCORSPolicy(cfg)
+	require.True(t, policy.isOriginAllowed("https://bridge.example.com"))
+	require.True(t, policy.isOriginAllowed("https://BRIDGE.example.com"))
+	require.True(t, policy.isOriginAllowed("https://app.example.org"))
+	require.True(t, policy.isOriginAllowed("https://a.b.example.org"))
+	require.False(t, policy.isOriginAllowed("https://example.org"))
+	require.False(t, policy.isOriginAllowed("https://.example.org"))
+	require.False(t, policy.isOriginAllowed("http://app.example.org"))
+	require.False(t, policy.isOriginAllowed("https://app.example.org.evil.com"))
+	require.False(t, policy.isOriginAllowed("https://example.com"))
+
+	// Requests without origin are not cross origin
+	rec := serveCORS(cfg, http.MethodGet, "", false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	rec = serveCORS(cfg, http.MethodGet, "https://evil.com", false)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	rec = serveCORS(cfg, http.MethodOptions, "https://evil.com", true)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = serveCORS(cfg, http.MethodGet, "https://app.example.org", false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "https://app.example.org", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "Origin", rec.Header().Get("Vary"))
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+
+	// No origin is allowed by default
+	rec = serveCORS(CORSConfig{}, http.MethodGet, "https://app.example.org", false)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCORSPreflight(t *testing.T) {
+	cfg := CORSConfig{
+		AllowedOrigins:   []string{"*"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Content-Type", "X-API-Key"},
+		AllowCredentials: true,
+		MaxAge:           types.NewDuration(10 * time.Minute),
+	}
+	rec := serveCORS(cfg, http.MethodOptions, "https://any.com", true)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "https://any.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "GET,POST", rec.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "Content-Type,X-API-Key", rec.Header().Get("Access-Control-Allow-Headers"))
+	require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	require.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+
+	// OPTIONS requests that are not preflights reach the API
+	rec = serveCORS(cfg, http.MethodOptions, "https://any.com", false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+}
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// HealthServiceAPI is the health service name of the bridge API, driven by the DB availability
+	HealthServiceAPI = "api"
+)
+
+// SyncHealthService returns the health service name of the synchronizer of a network.
+func SyncHealthService(networkID uint32) string {
+	return fmt.Sprintf("sync-%d", networkID)
+}
+
+// ClaimTxManagerHealthService returns the health service name of the claim tx manager of a rollup.
+func ClaimTxManagerHealthService(rollupID uint32) string {
+	return fmt.Sprintf("claimtxman-%d", rollupID)
+}
+
+// HealthChecker evaluates the health of the bridge services periodically and exposes the
+// results with the grpc.health.v1 protocol. The overall status (empty service name) is
+// SERVING only when all the services are SERVING.
+type HealthChecker struct {
+	cfg        HealthConfig
+	server     *health.Server
+	storage    healthCheckerStorage
+	networkIDs []uint32
+	syncStatus syncStatusTracker
+
+	mu              sync.RWMutex
+	claimTxManagers map[uint32]livenessReporter
+	statuses        map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+	stopped         bool
+}
+
+// NewHealthChecker creates a new health checker. The sync health of the networks is only checked
+// when a sync status tracker is provided.
+func NewHealthChecker(cfg HealthConfig, networkIDs []uint32, storage interface{}, syncStatus syncStatusTracker) *HealthChecker {
+	h := &HealthChecker{
+		cfg:             cfg,
+		server:          health.NewServer(),
+		storage:         storage.(healthCheckerStorage),
+		networkIDs:      networkIDs,
+		syncStatus:      syncStatus,
+		claimTxManagers: make(map[uint32]livenessReporter),
+		statuses:        make(map[string]grpc_health_v1.HealthCheckResponse_ServingStatus),
+	}
+	// Nothing is served until the first check is done
+	h.server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return h
+}
+
+// RegisterClaimTxManager adds the liveness of the claim tx manager of a rollup to the health checks.
+func (h *HealthChecker) RegisterClaimTxManager(rollupID uint32, claimTxManager livenessReporter) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.claimTxManagers[rollupID] = claimTxManager
+}
+
+// Start evaluates the health of the services every CheckInterval until the context is done.
+func (h *HealthChecker) Start(ctx context.Context) {
+	ticker := time.NewTicker(h.cfg.CheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		h.check(ctx)
+		select {
+		case <-ctx.Done():
+			h.shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// shutdown reports every service as NOT_SERVING, so the clients stop sending requests while the
+// server is stopping.
+func (h *HealthChecker) shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopped = true
+	for service := range h.statuses {
+		h.statuses[service] = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	h.server.Shutdown()
+}
+
+// check evaluates the health of every service and updates the statuses served.
+func (h *HealthChecker) check(ctx context.Context) {
+	statuses := map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+		HealthServiceAPI: h.checkAPI(ctx),
+	}
+	if h.syncStatus != nil {
+		for _, networkID := range h.networkIDs {
+			statuses[SyncHealthService(networkID)] = h.checkSync(ctx, networkID)
+		}
+	}
+	h.mu.RLock()
+	for rollupID, claimTxManager := range h.claimTxManagers {
+		statuses[ClaimTxManagerHealthService(rollupID)] = h.checkClaimTxManager(claimTxManager)
+	}
+	h.mu.RUnlock()
+
+	overall := grpc_health_v1.HealthCheckResponse_SERVING
+	for _, status := range statuses {
+		if status != grpc_health_v1.HealthCheckResponse_SERVING {
+			overall = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	statuses[""] = overall
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stopped {
+		return
+	}
+	services := make([]string, 0, len(statuses))
+	for service := range statuses {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		status := statuses[service]
+		if previous, ok := h.statuses[service]; !ok || previous != status {
+			if status == grpc_health_v1.HealthCheckResponse_SERVING {
+				log.Infof("health service %q is %s", service, status)
+			} else {
+				log.Warnf("health service %q is %s", service, status)
+			}
+		}
+		h.statuses[service] = status
+		h.server.SetServingStatus(service, status)
+	}
+}
+
+func (h *HealthChecker) checkAPI(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.CheckInterval.Duration)
+	defer cancel()
+	if err := h.storage.Ping(ctx); err != nil {
+		log.Errorf("health check: error pinging the DB: %v", err)
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func (h *HealthChecker) checkSync(ctx context.Context, networkID uint32) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	status, ok := h.syncStatus.GetNetworkStatus(networkID)
+	if !ok {
+		log.Debugf("health check: networkID %d, synchronizer status not reported yet", networkID)
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	if time.Since(status.UpdatedAt) > h.cfg.MaxSyncStatusAge.Duration {
+		log.Warnf("health check: networkID %d, synchronizer status not updated since %s", networkID, status.UpdatedAt)
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	var lastSyncedBlock uint64
+	lastBlock, err := h.storage.GetLastBlock(ctx, networkID, nil)
+	if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+		log.Errorf("health check: networkID %d, error getting the last synced block: %v", networkID, err)
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	if lastBlock != nil {
+		lastSyncedBlock = lastBlock.BlockNumber
+	}
+	if status.ChainHead > lastSyncedBlock+h.cfg.MaxSyncLag {
+		log.Warnf("health check: networkID %d, synchronizer is %d blocks behind the chain head", networkID, status.ChainHead-lastSyncedBlock)
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func (h *HealthChecker) checkClaimTxManager(claimTxManager livenessReporter) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if time.Since(claimTxManager.LastActivity()) > h.cfg.MaxClaimTxManagerInactivity.Duration {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// isAlive returns false when a component is stuck and only a restart can recover it. The
+// availability of the dependencies (DB, L1, L2) doesn't affect the liveness.
+func (h *HealthChecker) isAlive() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for rollupID := range h.claimTxManagers {
+		if status, ok := h.statuses[ClaimTxManagerHealthService(rollupID)]; ok && status != grpc_health_v1.HealthCheckResponse_SERVING {
+			return false
+		}
+	}
+	return true
+}
+
+// isReady returns true when all the services are healthy.
+func (h *HealthChecker) isReady() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.statuses[""] == grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// livenessHandler is the HTTP liveness probe.
+func (h *HealthChecker) livenessHandler(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeProbeResult(w, h.isAlive())
+}
+
+// readinessHandler is the HTTP readiness probe.
+func (h *HealthChecker) readinessHandler(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeProbeResult(w, h.isReady())
+}
+
+func writeProbeResult(w http.ResponseWriter, ok bool) {
+	w.Header().Set("Content-Type", "text/plain")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(grpc_health_v1.HealthCheckResponse_NOT_SERVING.String()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(grpc_health_v1.HealthCheckResponse_SERVING.String()))
+}
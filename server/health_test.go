@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/synchronizer"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func newTestHealthConfig() HealthConfig {
+	return HealthConfig{
+		CheckInterval:               types.NewDuration(time.Second),
+		MaxSyncLag:                  10,
+		MaxSyncStatusAge:            types.NewDuration(time.Minute),
+		MaxClaimTxManagerInactivity: types.NewDuration(time.Minute),
+	}
+}
+
+func getHealthStatus(t *testing.T, h *HealthChecker, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	res, err := h.server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return res.Status
+}
+
+func TestHealthChecker(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := newHealthCheckerStorageMock(t)
+	mockTracker := newSyncStatusTrackerMock(t)
+	mockClaimTxManager := newLivenessReporterMock(t)
+	h := NewHealthChecker(newTestHealthConfig(), []uint32{0, 1}, mockStorage, mockTracker)
+	h.RegisterClaimTxManager(1, mockClaimTxManager)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, ""))
+
+	// Everything healthy
+	mockStorage.EXPECT().Ping(mock.Anything).Return(nil).Once()
+	mockTracker.EXPECT().GetNetworkStatus(uint32(0)).Return(synchronizer.NetworkStatus{ChainHead: 105, UpdatedAt: time.Now()}, true).Once()
+	mockTracker.EXPECT().GetNetworkStatus(uint32(1)).Return(synchronizer.NetworkStatus{ChainHead: 50, UpdatedAt: time.Now()}, true).Once()
+	mockStorage.EXPECT().GetLastBlock(mock.Anything, uint32(0), mock.Anything).Return(&etherman.Block{BlockNumber: 100}, nil).Once()
+	mockStorage.EXPECT().GetLastBlock(mock.Anything, uint32(1), mock.Anything).Return(&etherman.Block{BlockNumber: 50}, nil).Once()
+	mockClaimTxManager.EXPECT().LastActivity().Return(time.Now()).Once()
+	h.check(ctx)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getHealthStatus(t, h, ""))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getHealthStatus(t, h, HealthServiceAPI))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getHealthStatus(t, h, SyncHealthService(0)))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getHealthStatus(t, h, SyncHealthService(1)))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getHealthStatus(t, h, ClaimTxManagerHealthService(1)))
+	require.True(t, h.isAlive())
+	require.True(t, h.isReady())
+
+	// DB down, L1 sync behind, L2 synchronizer not reporting and claim tx manager stuck
+	mockStorage.EXPECT().Ping(mock.Anything).Return(errors.New("connection refused")).Once()
+	mockTracker.EXPECT().GetNetworkStatus(uint32(0)).Return(synchronizer.NetworkStatus{ChainHead: 200, UpdatedAt: time.Now()}, true).Once()
+	mockTracker.EXPECT().GetNetworkStatus(uint32(1)).Return(synchronizer.NetworkStatus{ChainHead: 50, UpdatedAt: time.Now().Add(-time.Hour)}, true).Once()
+	mockStorage.EXPECT().GetLastBlock(mock.Anything, uint32(0), mock.Anything).Return(&etherman.Block{BlockNumber: 100}, nil).Once()
+	mockClaimTxManager.EXPECT().LastActivity().Return(time.Now().Add(-time.Hour)).Once()
+	h.check(ctx)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, ""))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, HealthServiceAPI))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, SyncHealthService(0)))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, SyncHealthService(1)))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, ClaimTxManagerHealthService(1)))
+	require.False(t, h.isAlive())
+	require.False(t, h.isReady())
+
+	_, err := h.server.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	require.Error(t, err)
+}
+
+func TestHealthCheckerProbes(t *testing.T) {
+	mockStorage := newHealthCheckerStorageMock(t)
+	h := NewHealthChecker(newTestHealthConfig(), []uint32{0, 1}, mockStorage, nil)
+
+	// Without sync status tracker only the DB is checked
+	mockStorage.EXPECT().Ping(mock.Anything).Return(errors.New("connection refused")).Once()
+	h.check(context.Background())
+
+	rec := httptest.NewRecorder()
+	h.livenessHandler(rec, httptest.NewRequest(http.MethodGet, "/health/live", nil), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = httptest.NewRecorder()
+	h.readinessHandler(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil), nil)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	mockStorage.EXPECT().Ping(mock.Anything).Return(nil).Once()
+	h.check(context.Background())
+	rec = httptest.NewRecorder()
+	h.readinessHandler(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Nothing is ready while the server is stopping
+	h.shutdown()
+	rec = httptest.NewRecorder()
+	h.readinessHandler(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil), nil)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getHealthStatus(t, h, HealthServiceAPI))
+}
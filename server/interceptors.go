@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"path"
+	"runtime/debug"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metricsInterceptor records the latency and the result code of every unary API request.
+func metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.APIRequest(path.Base(info.FullMethod), status.Code(err).String(), time.Since(start))
+	return resp, err
+}
+
+// recoveryInterceptor returns Internal when the handler of a unary API request panics, so a single
+// request can't crash the server.
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("panic handling %s: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// depositRequest is implemented by the API requests that refer to a deposit.
+type depositRequest interface {
+	GetNetId() uint32
+	GetDepositCnt() uint32
+}
+
+// tracingInterceptor adds the deposit identifiers of the request to the span of the API call.
+func tracingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if r, ok := req.(depositRequest); ok {
+		trace.SpanFromContext(ctx).SetAttributes(tracing.DepositCount(r.GetDepositCnt(), r.GetNetId())...)
+	}
+	return handler(ctx, req)
+}
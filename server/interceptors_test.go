@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/bridge.v1.BridgeService/VerifyClaimProof"}
+
+	// The panics are returned as Internal errors
+	_, err := recoveryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("bad request")
+	})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	// The responses are returned as they are
+	res, err := recoveryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
@@ -2,8 +2,12 @@ package server
 
 import (
 	"context"
+	"time"
 
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/synchronizer"
+	"github.com/fiwallets/go-ethereum"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 )
@@ -21,6 +25,33 @@ type bridgeServiceStorage interface {
 	GetDeposits(ctx context.Context, destAddr string, limit, offset uint32, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error)
 	GetTokenWrapped(ctx context.Context, originalNetwork uint32, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokenWrappedByAddress(ctx context.Context, networkID uint32, wrappedTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokensWrapped(ctx context.Context, networkID, originalNetwork *uint32, limit, offset uint32, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error)
+	GetTokensWrappedCount(ctx context.Context, networkID, originalNetwork *uint32, dbTx pgx.Tx) (uint64, error)
 	GetRollupExitLeavesByRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error)
 	GetPendingDepositsToClaim(ctx context.Context, destAddress common.Address, destNetwork, leafType, limit, offset uint32, dbTx pgx.Tx) ([]*etherman.Deposit, uint64, error)
+	GetDepositClaimStatus(ctx context.Context, depositCnt, networkID uint32, dbTx pgx.Tx) (*ctmtypes.DepositClaimStatus, error)
+	GetGERAllowed(ctx context.Context, ger common.Hash, networkID uint32, dbTx pgx.Tx) (bool, error)
+	GetLastBlock(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.Block, error)
+	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestTrustedExitRoot(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error)
+	GetClaimCosts(ctx context.Context, from, to time.Time, dbTx pgx.Tx) ([]*ctmtypes.ClaimCost, error)
+}
+
+type gasEstimator interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+type syncStatusTracker interface {
+	GetNetworkStatus(networkID uint32) (synchronizer.NetworkStatus, bool)
+}
+
+type healthCheckerStorage interface {
+	Ping(ctx context.Context) error
+	GetLastBlock(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.Block, error)
+}
+
+type livenessReporter interface {
+	LastActivity() time.Time
 }
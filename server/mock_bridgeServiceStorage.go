@@ -12,6 +12,10 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pgx "github.com/jackc/pgx/v4"
+
+	time "time"
+
+	types "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 )
 
 // bridgeServiceStorageMock is an autogenerated mock type for the bridgeServiceStorage type
@@ -149,6 +153,67 @@ func (_c *bridgeServiceStorageMock_GetClaim_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetClaimCosts provides a mock function with given fields: ctx, from, to, dbTx
+func (_m *bridgeServiceStorageMock) GetClaimCosts(ctx context.Context, from time.Time, to time.Time, dbTx pgx.Tx) ([]*types.ClaimCost, error) {
+	ret := _m.Called(ctx, from, to, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClaimCosts")
+	}
+
+	var r0 []*types.ClaimCost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, pgx.Tx) ([]*types.ClaimCost, error)); ok {
+		return rf(ctx, from, to, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, pgx.Tx) []*types.ClaimCost); ok {
+		r0 = rf(ctx, from, to, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.ClaimCost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, pgx.Tx) error); ok {
+		r1 = rf(ctx, from, to, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetClaimCosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClaimCosts'
+type bridgeServiceStorageMock_GetClaimCosts_Call struct {
+	*mock.Call
+}
+
+// GetClaimCosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetClaimCosts(ctx interface{}, from interface{}, to interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetClaimCosts_Call {
+	return &bridgeServiceStorageMock_GetClaimCosts_Call{Call: _e.mock.On("GetClaimCosts", ctx, from, to, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetClaimCosts_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetClaimCosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetClaimCosts_Call) Return(_a0 []*types.ClaimCost, _a1 error) *bridgeServiceStorageMock_GetClaimCosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetClaimCosts_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, pgx.Tx) ([]*types.ClaimCost, error)) *bridgeServiceStorageMock_GetClaimCosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClaimCount provides a mock function with given fields: ctx, destAddr, dbTx
 func (_m *bridgeServiceStorageMock) GetClaimCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, destAddr, dbTx)
@@ -330,6 +395,67 @@ func (_c *bridgeServiceStorageMock_GetDeposit_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetDepositClaimStatus provides a mock function with given fields: ctx, depositCnt, networkID, dbTx
+func (_m *bridgeServiceStorageMock) GetDepositClaimStatus(ctx context.Context, depositCnt uint32, networkID uint32, dbTx pgx.Tx) (*types.DepositClaimStatus, error) {
+	ret := _m.Called(ctx, depositCnt, networkID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositClaimStatus")
+	}
+
+	var r0 *types.DepositClaimStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32, pgx.Tx) (*types.DepositClaimStatus, error)); ok {
+		return rf(ctx, depositCnt, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32, pgx.Tx) *types.DepositClaimStatus); ok {
+		r0 = rf(ctx, depositCnt, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.DepositClaimStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, depositCnt, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetDepositClaimStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositClaimStatus'
+type bridgeServiceStorageMock_GetDepositClaimStatus_Call struct {
+	*mock.Call
+}
+
+// GetDepositClaimStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - depositCnt uint32
+//   - networkID uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetDepositClaimStatus(ctx interface{}, depositCnt interface{}, networkID interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetDepositClaimStatus_Call {
+	return &bridgeServiceStorageMock_GetDepositClaimStatus_Call{Call: _e.mock.On("GetDepositClaimStatus", ctx, depositCnt, networkID, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetDepositClaimStatus_Call) Run(run func(ctx context.Context, depositCnt uint32, networkID uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetDepositClaimStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(uint32), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetDepositClaimStatus_Call) Return(_a0 *types.DepositClaimStatus, _a1 error) *bridgeServiceStorageMock_GetDepositClaimStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetDepositClaimStatus_Call) RunAndReturn(run func(context.Context, uint32, uint32, pgx.Tx) (*types.DepositClaimStatus, error)) *bridgeServiceStorageMock_GetDepositClaimStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDepositCount provides a mock function with given fields: ctx, destAddr, dbTx
 func (_m *bridgeServiceStorageMock) GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, destAddr, dbTx)
@@ -509,6 +635,65 @@ func (_c *bridgeServiceStorageMock_GetDeposits_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetGERAllowed provides a mock function with given fields: ctx, ger, networkID, dbTx
+func (_m *bridgeServiceStorageMock) GetGERAllowed(ctx context.Context, ger common.Hash, networkID uint32, dbTx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, ger, networkID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGERAllowed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint32, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, ger, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint32, pgx.Tx) bool); ok {
+		r0 = rf(ctx, ger, networkID, dbTx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, ger, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetGERAllowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGERAllowed'
+type bridgeServiceStorageMock_GetGERAllowed_Call struct {
+	*mock.Call
+}
+
+// GetGERAllowed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ger common.Hash
+//   - networkID uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetGERAllowed(ctx interface{}, ger interface{}, networkID interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetGERAllowed_Call {
+	return &bridgeServiceStorageMock_GetGERAllowed_Call{Call: _e.mock.On("GetGERAllowed", ctx, ger, networkID, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetGERAllowed_Call) Run(run func(ctx context.Context, ger common.Hash, networkID uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetGERAllowed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(uint32), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetGERAllowed_Call) Return(_a0 bool, _a1 error) *bridgeServiceStorageMock_GetGERAllowed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetGERAllowed_Call) RunAndReturn(run func(context.Context, common.Hash, uint32, pgx.Tx) (bool, error)) *bridgeServiceStorageMock_GetGERAllowed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetL1ExitRootByGER provides a mock function with given fields: ctx, ger, dbTx
 func (_m *bridgeServiceStorageMock) GetL1ExitRootByGER(ctx context.Context, ger common.Hash, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
 	ret := _m.Called(ctx, ger, dbTx)
@@ -569,6 +754,66 @@ func (_c *bridgeServiceStorageMock_GetL1ExitRootByGER_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetLastBlock provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *bridgeServiceStorageMock) GetLastBlock(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.Block, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastBlock")
+	}
+
+	var r0 *etherman.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) (*etherman.Block, error)); ok {
+		return rf(ctx, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) *etherman.Block); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetLastBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastBlock'
+type bridgeServiceStorageMock_GetLastBlock_Call struct {
+	*mock.Call
+}
+
+// GetLastBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - networkID uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetLastBlock(ctx interface{}, networkID interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetLastBlock_Call {
+	return &bridgeServiceStorageMock_GetLastBlock_Call{Call: _e.mock.On("GetLastBlock", ctx, networkID, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetLastBlock_Call) Run(run func(ctx context.Context, networkID uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetLastBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLastBlock_Call) Return(_a0 *etherman.Block, _a1 error) *bridgeServiceStorageMock_GetLastBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLastBlock_Call) RunAndReturn(run func(context.Context, uint32, pgx.Tx) (*etherman.Block, error)) *bridgeServiceStorageMock_GetLastBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestExitRoot provides a mock function with given fields: ctx, networkID, destNetwork, dbTx
 func (_m *bridgeServiceStorageMock) GetLatestExitRoot(ctx context.Context, networkID uint32, destNetwork uint32, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
 	ret := _m.Called(ctx, networkID, destNetwork, dbTx)
@@ -630,6 +875,184 @@ func (_c *bridgeServiceStorageMock_GetLatestExitRoot_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetLatestL1SyncedExitRoot provides a mock function with given fields: ctx, dbTx
+func (_m *bridgeServiceStorageMock) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestL1SyncedExitRoot")
+	}
+
+	var r0 *etherman.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*etherman.GlobalExitRoot, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestL1SyncedExitRoot'
+type bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetLatestL1SyncedExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetLatestL1SyncedExitRoot(ctx interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call {
+	return &bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call{Call: _e.mock.On("GetLatestL1SyncedExitRoot", ctx, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call) Return(_a0 *etherman.GlobalExitRoot, _a1 error) *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call) RunAndReturn(run func(context.Context, pgx.Tx) (*etherman.GlobalExitRoot, error)) *bridgeServiceStorageMock_GetLatestL1SyncedExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestRollupExitLeaves provides a mock function with given fields: ctx, dbTx
+func (_m *bridgeServiceStorageMock) GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestRollupExitLeaves")
+	}
+
+	var r0 []etherman.RollupExitLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]etherman.RollupExitLeaf, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []etherman.RollupExitLeaf); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]etherman.RollupExitLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestRollupExitLeaves'
+type bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call struct {
+	*mock.Call
+}
+
+// GetLatestRollupExitLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetLatestRollupExitLeaves(ctx interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call {
+	return &bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call{Call: _e.mock.On("GetLatestRollupExitLeaves", ctx, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call) Return(_a0 []etherman.RollupExitLeaf, _a1 error) *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call) RunAndReturn(run func(context.Context, pgx.Tx) ([]etherman.RollupExitLeaf, error)) *bridgeServiceStorageMock_GetLatestRollupExitLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestTrustedExitRoot provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *bridgeServiceStorageMock) GetLatestTrustedExitRoot(ctx context.Context, networkID uint32, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestTrustedExitRoot")
+	}
+
+	var r0 *etherman.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) (*etherman.GlobalExitRoot, error)); ok {
+		return rf(ctx, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) *etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestTrustedExitRoot'
+type bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetLatestTrustedExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - networkID uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetLatestTrustedExitRoot(ctx interface{}, networkID interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call {
+	return &bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call{Call: _e.mock.On("GetLatestTrustedExitRoot", ctx, networkID, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call) Run(run func(ctx context.Context, networkID uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call) Return(_a0 *etherman.GlobalExitRoot, _a1 error) *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call) RunAndReturn(run func(context.Context, uint32, pgx.Tx) (*etherman.GlobalExitRoot, error)) *bridgeServiceStorageMock_GetLatestTrustedExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPendingDepositsToClaim provides a mock function with given fields: ctx, destAddress, destNetwork, leafType, limit, offset, dbTx
 func (_m *bridgeServiceStorageMock) GetPendingDepositsToClaim(ctx context.Context, destAddress common.Address, destNetwork uint32, leafType uint32, limit uint32, offset uint32, dbTx pgx.Tx) ([]*etherman.Deposit, uint64, error) {
 	ret := _m.Called(ctx, destAddress, destNetwork, leafType, limit, offset, dbTx)
@@ -883,6 +1306,189 @@ func (_c *bridgeServiceStorageMock_GetTokenWrapped_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetTokenWrappedByAddress provides a mock function with given fields: ctx, networkID, wrappedTokenAddress, dbTx
+func (_m *bridgeServiceStorageMock) GetTokenWrappedByAddress(ctx context.Context, networkID uint32, wrappedTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	ret := _m.Called(ctx, networkID, wrappedTokenAddress, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenWrappedByAddress")
+	}
+
+	var r0 *etherman.TokenWrapped
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, common.Address, pgx.Tx) (*etherman.TokenWrapped, error)); ok {
+		return rf(ctx, networkID, wrappedTokenAddress, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, common.Address, pgx.Tx) *etherman.TokenWrapped); ok {
+		r0 = rf(ctx, networkID, wrappedTokenAddress, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.TokenWrapped)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, common.Address, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, wrappedTokenAddress, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetTokenWrappedByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenWrappedByAddress'
+type bridgeServiceStorageMock_GetTokenWrappedByAddress_Call struct {
+	*mock.Call
+}
+
+// GetTokenWrappedByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - networkID uint32
+//   - wrappedTokenAddress common.Address
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetTokenWrappedByAddress(ctx interface{}, networkID interface{}, wrappedTokenAddress interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call {
+	return &bridgeServiceStorageMock_GetTokenWrappedByAddress_Call{Call: _e.mock.On("GetTokenWrappedByAddress", ctx, networkID, wrappedTokenAddress, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call) Run(run func(ctx context.Context, networkID uint32, wrappedTokenAddress common.Address, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(common.Address), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call) Return(_a0 *etherman.TokenWrapped, _a1 error) *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call) RunAndReturn(run func(context.Context, uint32, common.Address, pgx.Tx) (*etherman.TokenWrapped, error)) *bridgeServiceStorageMock_GetTokenWrappedByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokensWrapped provides a mock function with given fields: ctx, networkID, originalNetwork, limit, offset, dbTx
+func (_m *bridgeServiceStorageMock) GetTokensWrapped(ctx context.Context, networkID *uint32, originalNetwork *uint32, limit uint32, offset uint32, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error) {
+	ret := _m.Called(ctx, networkID, originalNetwork, limit, offset, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokensWrapped")
+	}
+
+	var r0 []*etherman.TokenWrapped
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uint32, *uint32, uint32, uint32, pgx.Tx) ([]*etherman.TokenWrapped, error)); ok {
+		return rf(ctx, networkID, originalNetwork, limit, offset, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uint32, *uint32, uint32, uint32, pgx.Tx) []*etherman.TokenWrapped); ok {
+		r0 = rf(ctx, networkID, originalNetwork, limit, offset, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.TokenWrapped)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uint32, *uint32, uint32, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, originalNetwork, limit, offset, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetTokensWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokensWrapped'
+type bridgeServiceStorageMock_GetTokensWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTokensWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - networkID *uint32
+//   - originalNetwork *uint32
+//   - limit uint32
+//   - offset uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetTokensWrapped(ctx interface{}, networkID interface{}, originalNetwork interface{}, limit interface{}, offset interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetTokensWrapped_Call {
+	return &bridgeServiceStorageMock_GetTokensWrapped_Call{Call: _e.mock.On("GetTokensWrapped", ctx, networkID, originalNetwork, limit, offset, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrapped_Call) Run(run func(ctx context.Context, networkID *uint32, originalNetwork *uint32, limit uint32, offset uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetTokensWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uint32), args[2].(*uint32), args[3].(uint32), args[4].(uint32), args[5].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrapped_Call) Return(_a0 []*etherman.TokenWrapped, _a1 error) *bridgeServiceStorageMock_GetTokensWrapped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrapped_Call) RunAndReturn(run func(context.Context, *uint32, *uint32, uint32, uint32, pgx.Tx) ([]*etherman.TokenWrapped, error)) *bridgeServiceStorageMock_GetTokensWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokensWrappedCount provides a mock function with given fields: ctx, networkID, originalNetwork, dbTx
+func (_m *bridgeServiceStorageMock) GetTokensWrappedCount(ctx context.Context, networkID *uint32, originalNetwork *uint32, dbTx pgx.Tx) (uint64, error) {
+	ret := _m.Called(ctx, networkID, originalNetwork, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokensWrappedCount")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uint32, *uint32, pgx.Tx) (uint64, error)); ok {
+		return rf(ctx, networkID, originalNetwork, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uint32, *uint32, pgx.Tx) uint64); ok {
+		r0 = rf(ctx, networkID, originalNetwork, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uint32, *uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, originalNetwork, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// bridgeServiceStorageMock_GetTokensWrappedCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokensWrappedCount'
+type bridgeServiceStorageMock_GetTokensWrappedCount_Call struct {
+	*mock.Call
+}
+
+// GetTokensWrappedCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - networkID *uint32
+//   - originalNetwork *uint32
+//   - dbTx pgx.Tx
+func (_e *bridgeServiceStorageMock_Expecter) GetTokensWrappedCount(ctx interface{}, networkID interface{}, originalNetwork interface{}, dbTx interface{}) *bridgeServiceStorageMock_GetTokensWrappedCount_Call {
+	return &bridgeServiceStorageMock_GetTokensWrappedCount_Call{Call: _e.mock.On("GetTokensWrappedCount", ctx, networkID, originalNetwork, dbTx)}
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrappedCount_Call) Run(run func(ctx context.Context, networkID *uint32, originalNetwork *uint32, dbTx pgx.Tx)) *bridgeServiceStorageMock_GetTokensWrappedCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uint32), args[2].(*uint32), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrappedCount_Call) Return(_a0 uint64, _a1 error) *bridgeServiceStorageMock_GetTokensWrappedCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *bridgeServiceStorageMock_GetTokensWrappedCount_Call) RunAndReturn(run func(context.Context, *uint32, *uint32, pgx.Tx) (uint64, error)) *bridgeServiceStorageMock_GetTokensWrappedCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newBridgeServiceStorageMock creates a new instance of bridgeServiceStorageMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newBridgeServiceStorageMock(t interface {
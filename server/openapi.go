@@ -0,0 +1,81 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// swaggerInitializer replaces the initializer of the Swagger UI distribution to load the OpenAPI
+// document of the API instead of the example one
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: %s,
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// openAPIDocs serves the OpenAPI document of the API and the Swagger UI viewer.
+type openAPIDocs struct {
+	specPath    string
+	uiPath      string
+	initializer []byte
+}
+
+// newOpenAPIDocs validates the paths of the OpenAPI config. It returns nil when the docs are disabled.
+func newOpenAPIDocs(cfg OpenAPIConfig) (*openAPIDocs, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	specPath := cfg.SpecPath
+	uiPath := strings.TrimSuffix(cfg.UIPath, "/")
+	if !strings.HasPrefix(specPath, "/") || !strings.HasPrefix(uiPath, "/") {
+		return nil, fmt.Errorf("invalid OpenAPI paths, they must start with '/': SpecPath '%s', UIPath '%s'", cfg.SpecPath, cfg.UIPath)
+	}
+	if specPath == uiPath || strings.HasPrefix(specPath, uiPath+"/") {
+		return nil, fmt.Errorf("the OpenAPI SpecPath '%s' can't be served under the UIPath '%s'", cfg.SpecPath, cfg.UIPath)
+	}
+	specURL, err := json.Marshal(specPath)
+	if err != nil {
+		return nil, err
+	}
+	return &openAPIDocs{
+		specPath:    specPath,
+		uiPath:      uiPath,
+		initializer: []byte(fmt.Sprintf(swaggerInitializer, specURL)),
+	}, nil
+}
+
+// handler serves the OpenAPI document at the SpecPath and the Swagger UI viewer at the UIPath.
+// The rest of the requests are served by the API handler.
+func (d *openAPIDocs) handler(api http.Handler) http.Handler {
+	if d == nil {
+		return api
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", api)
+	mux.HandleFunc(d.specPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(pb.OpenAPISpec)
+	})
+	mux.Handle(d.uiPath+"/", http.StripPrefix(d.uiPath, http.FileServer(http.FS(swaggerFiles.FS))))
+	mux.HandleFunc(d.uiPath+"/swagger-initializer.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		_, _ = w.Write(d.initializer)
+	})
+	return mux
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+func TestNewOpenAPIDocs(t *testing.T) {
+	docs, err := newOpenAPIDocs(OpenAPIConfig{Enabled: false, SpecPath: "invalid"})
+	require.NoError(t, err)
+	require.Nil(t, docs)
+
+	_, err = newOpenAPIDocs(OpenAPIConfig{Enabled: true, SpecPath: "openapi.json", UIPath: "/swagger-ui"})
+	require.Error(t, err)
+	_, err = newOpenAPIDocs(OpenAPIConfig{Enabled: true, SpecPath: "/openapi.json", UIPath: ""})
+	require.Error(t, err)
+	_, err = newOpenAPIDocs(OpenAPIConfig{Enabled: true, SpecPath: "/docs/openapi.json", UIPath: "/docs/"})
+	require.Error(t, err)
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	serve := func(h http.Handler, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	// The API is served directly when the docs are disabled
+	var disabled *openAPIDocs
+	require.Equal(t, http.StatusTeapot, serve(disabled.handler(api), "/openapi.json").Code)
+
+	docs, err := newOpenAPIDocs(OpenAPIConfig{Enabled: true, SpecPath: "/openapi.json", UIPath: "/swagger-ui/"})
+	require.NoError(t, err)
+	h := docs.handler(api)
+
+	rec := serve(h, "/openapi.json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var spec struct {
+		Swagger string                     `json:"swagger"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+	require.Equal(t, "2.0", spec.Swagger)
+	require.Contains(t, spec.Paths, "/bridges/{dest_addr}")
+	require.Contains(t, spec.Paths, "/merkle-proof")
+
+	rec = serve(h, "/swagger-ui")
+	require.Equal(t, http.StatusMovedPermanently, rec.Code)
+	require.Equal(t, "/swagger-ui/", rec.Header().Get("Location"))
+	rec = serve(h, "/swagger-ui/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "swagger-initializer.js")
+	rec = serve(h, "/swagger-ui/swagger-ui-bundle.js")
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = serve(h, "/swagger-ui/swagger-initializer.js")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `url: "/openapi.json"`)
+
+	require.Equal(t, http.StatusTeapot, serve(h, "/bridges/0x0").Code)
+	require.Equal(t, http.StatusTeapot, serve(h, "/").Code)
+}
+
+func TestGRPCReflection(t *testing.T) {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	pb.RegisterBridgeServiceServer(s, pb.UnimplementedBridgeServiceServer{})
+	reflection.Register(s)
+	go func() {
+		_ = s.Serve(listen)
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.NewClient(listen.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	require.Contains(t, services, "bridge.v1.BridgeService")
+
+	// The proto files are resolved by the reflection service
+	require.NoError(t, stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "bridge.v1.BridgeService"},
+	}))
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+}
@@ -2,129 +2,153 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
-	"os"
-	"os/signal"
-	"strings"
 	"time"
 
 	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
 	"github.com/fiwallets/zkevm-bridge-service/log"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// RunServer runs gRPC server and HTTP gateway
-func RunServer(cfg Config, bridgeService pb.BridgeServiceServer) error {
-	ctx := context.Background()
+// Server is the gRPC server and the HTTP/REST gateway of the bridge service
+type Server struct {
+	grpcServer    *grpc.Server
+	restServer    *http.Server
+	gatewayConn   *grpc.ClientConn
+	healthChecker *HealthChecker
+	cancelHealth  context.CancelFunc
+	grpcStopped   chan struct{}
+	restStopped   chan struct{}
+}
 
+// RunServer runs gRPC server and HTTP gateway
+func RunServer(cfg Config, bridgeService pb.BridgeServiceServer, healthChecker *HealthChecker) (*Server, error) {
 	if len(cfg.GRPCPort) == 0 {
-		return fmt.Errorf("invalid TCP port for gRPC server: '%s'", cfg.GRPCPort)
+		return nil, fmt.Errorf("invalid TCP port for gRPC server: '%s'", cfg.GRPCPort)
 	}
 
 	if len(cfg.HTTPPort) == 0 {
-		return fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
+		return nil, fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
+	}
+
+	serverTLS, err := newServerTLS(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	access, err := newAccessController(cfg.Auth, cfg.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := newOpenAPIDocs(cfg.OpenAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		grpcServer:    newGRPCServer(bridgeService, healthChecker, serverTLS, access, cfg.EnableReflection),
+		healthChecker: healthChecker,
+		cancelHealth:  cancel,
+		grpcStopped:   make(chan struct{}),
+		restStopped:   make(chan struct{}),
+	}
+	s.restServer, s.gatewayConn, err = newRestServer(cfg, healthChecker, serverTLS, access, docs)
+	if err != nil {
+		cancel()
+		return nil, err
 	}
 
+	go healthChecker.Start(ctx)
+
 	go func() {
-		_ = runRestServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		defer close(s.restStopped)
+		if err := runRestServer(s.restServer, cfg.HTTPPort); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("restful server error: %v", err)
+		}
 	}()
 
 	go func() {
-		_ = runGRPCServer(ctx, bridgeService, cfg.GRPCPort)
+		defer close(s.grpcStopped)
+		if err := runGRPCServer(s.grpcServer, cfg.GRPCPort); err != nil {
+			log.Errorf("gRPC server error: %v", err)
+		}
 	}()
 
-	return nil
+	return s, nil
 }
 
-// HealthChecker will provide an implementation of the HealthCheck interface.
-type healthChecker struct{}
+// Stop reports the services as not serving, stops accepting new requests and waits for the
+// requests in flight to finish. When the context is done the remaining connections are closed.
+func (s *Server) Stop(ctx context.Context) error {
+	s.healthChecker.shutdown()
+	s.cancelHealth()
+	// The gateway forwards the HTTP requests to the gRPC server, so it's drained first
+	err := s.restServer.Shutdown(ctx)
+	if err != nil {
+		_ = s.restServer.Close()
+	}
+	<-s.restStopped
+	_ = s.gatewayConn.Close()
 
-// NewHealthChecker returns a health checker according to standard package
-// grpc.health.v1.
-func newHealthChecker() *healthChecker {
-	return &healthChecker{}
+	grpcDrained := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(grpcDrained)
+	}()
+	select {
+	case <-grpcDrained:
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		err = errors.Join(err, ctx.Err())
+	}
+	<-s.grpcStopped
+	return err
 }
 
-// HealthCheck interface implementation.
-
-// Check returns the current status of the server for unary gRPC health requests,
-// for now if the server is up and able to respond we will always return SERVING.
-func (s *healthChecker) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	}, nil
-}
+func newGRPCServer(bridgeServer pb.BridgeServiceServer, healthChecker *HealthChecker, serverTLS *serverTLS, access *accessController, enableReflection bool) *grpc.Server {
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metricsInterceptor, recoveryInterceptor, access.unaryInterceptor, tracingInterceptor),
+	}
+	server := grpc.NewServer(append(opts, serverTLS.grpcServerOptions()...)...)
+	pb.RegisterBridgeServiceServer(server, bridgeServer)
 
-// Watch returns the current status of the server for stream gRPC health requests,
-// for now if the server is up and able to respond we will always return SERVING.
-func (s *healthChecker) Watch(req *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
-	return server.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	grpc_health_v1.RegisterHealthServer(server, healthChecker.server)
+	if enableReflection {
+		reflection.Register(server)
+	}
+	return server
 }
 
-func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, port string) error {
+func runGRPCServer(server *grpc.Server, port string) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
-	server := grpc.NewServer()
-	pb.RegisterBridgeServiceServer(server, bridgeServer)
-
-	healthService := newHealthChecker()
-	grpc_health_v1.RegisterHealthServer(server, healthService)
-
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
-	go func() {
-		for range c {
-			server.GracefulStop()
-			<-ctx.Done()
-		}
-	}()
-
 	log.Info("gRPC Server is serving at ", port)
 	return server.Serve(listen)
 }
 
-func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
-	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
-	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
-	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
-}
-
-// allowCORS allows Cross Origin Resource Sharing from any origin.
-// Don't do this without consideration in production systems.
-func allowCORS(h http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if origin := r.Header.Get("Origin"); origin != "" {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
-			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-				preflightHandler(w, r)
-				return
-			}
-		}
-		h.ServeHTTP(w, r)
-	})
-}
-
-func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	endpoint := "localhost:" + grpcPort
+func newRestServer(cfg Config, healthChecker *HealthChecker, serverTLS *serverTLS, access *accessController, docs *openAPIDocs) (*http.Server, *grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(serverTLS.gatewayDial),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(access.gatewayUnaryInterceptor),
+	}
+	endpoint := "localhost:" + cfg.GRPCPort
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	muxHealthOpt := runtime.WithHealthzEndpoint(grpc_health_v1.NewHealthClient(conn))
@@ -139,30 +163,33 @@ func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
 	})
 	mux := runtime.NewServeMux(muxJSONOpt, muxHealthOpt)
 
-	if err := pb.RegisterBridgeServiceHandler(ctx, mux, conn); err != nil {
-		return err
+	if err := pb.RegisterBridgeServiceHandler(context.Background(), mux, conn); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/health/live", healthChecker.livenessHandler); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/health/ready", healthChecker.readinessHandler); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
 	}
 
 	srv := &http.Server{
 		ReadTimeout: 1 * time.Second, //nolint:gomnd
-		Addr:        ":" + httpPort,
-		Handler:     allowCORS(mux),
+		Addr:        ":" + cfg.HTTPPort,
+		Handler:     newCORSPolicy(cfg.CORS).handler(access.httpMiddleware(docs.handler(mux))),
+		TLSConfig:   serverTLS.restServer,
 	}
+	return srv, conn, nil
+}
 
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
-	go func() {
-		for range c {
-			_ = srv.Shutdown(ctx)
-			<-ctx.Done()
-		}
-
-		_, cancel := context.WithTimeout(ctx, 5*time.Second) //nolint:gomnd
-		defer cancel()
-
-		_ = srv.Shutdown(ctx)
-	}()
-
-	log.Info("Restful Server is serving at ", httpPort)
+func runRestServer(srv *http.Server, port string) error {
+	if srv.TLSConfig != nil {
+		log.Info("Restful Server is serving over TLS at ", port)
+		return srv.ListenAndServeTLS("", "")
+	}
+	log.Info("Restful Server is serving at ", port)
 	return srv.ListenAndServe()
 }
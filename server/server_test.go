@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func freePort(t *testing.T) string {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listen.Close()
+	return strconv.Itoa(listen.Addr().(*net.TCPAddr).Port)
+}
+
+func TestServerStop(t *testing.T) {
+	mockStorage := newHealthCheckerStorageMock(t)
+	mockStorage.EXPECT().Ping(mock.Anything).Return(nil).Maybe()
+	cfg := Config{
+		GRPCPort: freePort(t),
+		HTTPPort: freePort(t),
+		Health:   newTestHealthConfig(),
+	}
+	healthChecker := NewHealthChecker(cfg.Health, []uint32{0}, mockStorage, nil)
+	s, err := RunServer(cfg, pb.UnimplementedBridgeServiceServer{}, healthChecker)
+	require.NoError(t, err)
+
+	liveURL := "http://127.0.0.1:" + cfg.HTTPPort + "/health/live"
+	require.Eventually(t, func() bool {
+		res, err := http.Get(liveURL) //nolint:gosec
+		if err != nil {
+			return false
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusOK
+	}, 5*time.Second, 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Stop(ctx))
+	require.False(t, healthChecker.isReady())
+
+	// The ports are released
+	_, err = http.Get(liveURL) //nolint:gosec
+	require.Error(t, err)
+	listen, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	require.NoError(t, err)
+	listen.Close()
+}
@@ -3,16 +3,63 @@ package server
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
+	"time"
 
 	"github.com/fiwallets/zkevm-bridge-service/bridgectrl"
 	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/etherman/smartcontracts/polygonzkevmbridgev2"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/utils"
 	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
+	"github.com/fiwallets/go-ethereum"
 	"github.com/fiwallets/go-ethereum/common"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// BridgeStatusDeposited means the deposit is synced but its exit root is not in any L1 global exit root yet
+	BridgeStatusDeposited = "deposited"
+	// BridgeStatusWaitingGER means the exit root of the deposit is in an L1 global exit root that is not usable in the destination network yet
+	BridgeStatusWaitingGER = "waiting_ger"
+	// BridgeStatusReadyForClaim means the deposit can be claimed in the destination network
+	BridgeStatusReadyForClaim = "ready_for_claim"
+	// BridgeStatusAutoClaimQueued means the claim tx manager has created the claim tx but it has not been sent yet
+	BridgeStatusAutoClaimQueued = "autoclaim_queued"
+	// BridgeStatusAutoClaimCompressing means the claim tx is being compressed with other claims
+	BridgeStatusAutoClaimCompressing = "autoclaim_compressing"
+	// BridgeStatusAutoClaimSent means the claim tx (or the compressed one) has been sent and is waiting to be mined
+	BridgeStatusAutoClaimSent = "autoclaim_sent"
+	// BridgeStatusAutoClaimFailed means the claim tx manager was not able to claim the deposit
+	BridgeStatusAutoClaimFailed = "autoclaim_failed"
+	// BridgeStatusAutoClaimRejected means the claim tx manager doesn't claim the deposit because of the claim policies
+	BridgeStatusAutoClaimRejected = "autoclaim_rejected"
+	// BridgeStatusClaimed means the deposit has been claimed in the destination network
+	BridgeStatusClaimed = "claimed"
+)
+
+const (
+	// ProofLevelGlobalIndex means the global index doesn't match the deposit
+	ProofLevelGlobalIndex = "global_index"
+	// ProofLevelLocalExitRoot means the local exit root computed from the leaf and the smt proof doesn't match
+	ProofLevelLocalExitRoot = "local_exit_root"
+	// ProofLevelRollupExitRoot means the rollup exit root computed from the local exit root and the rollup smt proof doesn't match
+	ProofLevelRollupExitRoot = "rollup_exit_root"
+)
+
+const (
+	// claimCostsDayLayout is the layout of the days of the claim costs report
+	claimCostsDayLayout = "2006-01-02"
+	// claimCostsDefaultDays is the number of days of the claim costs report if the from day is not set
+	claimCostsDefaultDays = 30
 )
 
 type bridgeService struct {
@@ -23,6 +70,9 @@ type bridgeService struct {
 	maxPageLimit     uint32
 	version          string
 	cache            *lru.Cache[string, [][]byte]
+	bridgeAddresses  map[uint32]common.Address
+	gasEstimators    map[uint32]gasEstimator
+	syncStatus       syncStatusTracker
 	pb.UnimplementedBridgeServiceServer
 }
 
@@ -44,9 +94,25 @@ func NewBridgeService(cfg Config, height uint8, networks []uint32, storage inter
 		maxPageLimit:     cfg.MaxPageLimit,
 		version:          cfg.BridgeVersion,
 		cache:            cache,
+		bridgeAddresses:  make(map[uint32]common.Address),
+		gasEstimators:    make(map[uint32]gasEstimator),
+	}
+}
+
+// RegisterNetwork sets the bridge smart contract address of a network, used to build claim txs for it. If the
+// client is able to estimate gas, it is used to estimate the claim txs sent to this network.
+func (s *bridgeService) RegisterNetwork(networkID uint32, bridgeAddress common.Address, client interface{}) {
+	s.bridgeAddresses[networkID] = bridgeAddress
+	if estimator, ok := client.(gasEstimator); ok {
+		s.gasEstimators[networkID] = estimator
 	}
 }
 
+// SetSyncStatusTracker sets the tracker used to report the live synchronization status of the networks.
+func (s *bridgeService) SetSyncStatusTracker(tracker syncStatusTracker) {
+	s.syncStatus = tracker
+}
+
 // getNode returns the children hash pairs for a given parent hash.
 func (s *bridgeService) getNode(ctx context.Context, parentHash [bridgectrl.KeyLen]byte, dbTx pgx.Tx) (left, right [bridgectrl.KeyLen]byte, err error) {
 	value, ok := s.cache.Get(string(parentHash[:]))
@@ -63,15 +129,40 @@ func (s *bridgeService) getNode(ctx context.Context, parentHash [bridgectrl.KeyL
 	return left, right, nil
 }
 
+// nodeLookups keeps the children hash pairs already resolved while building a batch of proofs,
+// so proofs that share the upper part of the tree don't query the same nodes again.
+type nodeLookups map[[bridgectrl.KeyLen]byte][2][bridgectrl.KeyLen]byte
+
+// getSharedNode returns the children hash pairs for a given parent hash, using the batch lookups if provided.
+func (s *bridgeService) getSharedNode(ctx context.Context, parentHash [bridgectrl.KeyLen]byte, lookups nodeLookups, dbTx pgx.Tx) (left, right [bridgectrl.KeyLen]byte, err error) {
+	if lookups == nil {
+		return s.getNode(ctx, parentHash, dbTx)
+	}
+	if children, ok := lookups[parentHash]; ok {
+		return children[0], children[1], nil
+	}
+	left, right, err = s.getNode(ctx, parentHash, dbTx)
+	if err != nil {
+		return left, right, err
+	}
+	lookups[parentHash] = [2][bridgectrl.KeyLen]byte{left, right}
+	return left, right, nil
+}
+
 // getProof returns the merkle proof for a given index and root.
 func (s *bridgeService) getProof(index uint32, root [bridgectrl.KeyLen]byte, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, error) {
+	return s.getSharedProof(index, root, nil, dbTx)
+}
+
+// getSharedProof returns the merkle proof for a given index and root, sharing the node lookups of a batch.
+func (s *bridgeService) getSharedProof(index uint32, root [bridgectrl.KeyLen]byte, lookups nodeLookups, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, error) {
 	var siblings [][bridgectrl.KeyLen]byte
 
 	cur := root
 	ctx := context.Background()
 	// It starts in height-1 because 0 is the level of the leafs
 	for h := int(s.height - 1); h >= 0; h-- {
-		left, right, err := s.getNode(ctx, cur, dbTx)
+		left, right, err := s.getSharedNode(ctx, cur, lookups, dbTx)
 		if err != nil {
 			return nil, fmt.Errorf("height: %d, cur: %s, error: %v", h, common.BytesToHash(cur[:]).String(), err)
 		}
@@ -292,6 +383,88 @@ func (s *bridgeService) GetClaimProofForCompressed(ger common.Hash, depositCnt,
 	return globalExitRoot, merkleProof, rollupMerkleProof, nil
 }
 
+// GetClaimProofs returns the merkle proofs to claim the given deposits, all of them resolved against the same
+// global exit root. Node lookups and rollup exit proofs are shared between the deposits of the batch.
+func (s *bridgeService) GetClaimProofs(depositKeys []*pb.DepositKey, dbTx pgx.Tx) (*etherman.GlobalExitRoot, [][][bridgectrl.KeyLen]byte, [][][bridgectrl.KeyLen]byte, error) {
+	ctx := context.Background()
+	if len(depositKeys) == 0 {
+		return nil, nil, nil, fmt.Errorf("no deposits requested")
+	}
+
+	// The mainnet deposits can only be claimed with a GER already synced in the destination network, while
+	// the rollup deposits are claimable with the latest L1 GER, so a batch can't mix them. All the deposits
+	// of the batch must have the same destination network.
+	var (
+		networkID = depositKeys[0].NetId
+		destNet   uint32
+	)
+	for i, key := range depositKeys {
+		deposit, err := s.storage.GetDeposit(ctx, key.DepositCnt, key.NetId, dbTx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error getting deposit %d for network: %d. Err: %w", key.DepositCnt, key.NetId, err)
+		}
+		if !deposit.ReadyForClaim {
+			return nil, nil, nil, fmt.Errorf("deposit %d of network %d: %w", key.DepositCnt, key.NetId, gerror.ErrDepositNotSynced)
+		}
+		if i == 0 {
+			destNet = deposit.DestinationNetwork
+		}
+		if (key.NetId == 0) != (networkID == 0) {
+			return nil, nil, nil, fmt.Errorf("mainnet and rollup deposits can't share the same GER")
+		}
+		if deposit.DestinationNetwork != destNet {
+			return nil, nil, nil, fmt.Errorf("deposits with different destination networks (%d and %d) can't share the same GER", destNet, deposit.DestinationNetwork)
+		}
+	}
+
+	globalExitRoot, err := s.storage.GetLatestExitRoot(ctx, networkID, destNet, dbTx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		lookups            = make(nodeLookups)
+		rollupProofs       = make(map[uint32][][bridgectrl.KeyLen]byte)
+		rollupLeaves       = make(map[uint32]common.Hash)
+		merkleProofs       = make([][][bridgectrl.KeyLen]byte, 0, len(depositKeys))
+		rollupMerkleProofs = make([][][bridgectrl.KeyLen]byte, 0, len(depositKeys))
+	)
+	for _, key := range depositKeys {
+		var (
+			merkleProof       [][bridgectrl.KeyLen]byte
+			rollupMerkleProof [][bridgectrl.KeyLen]byte
+		)
+		if key.NetId == 0 { // Mainnet
+			merkleProof, err = s.getSharedProof(key.DepositCnt, globalExitRoot.ExitRoots[0], lookups, dbTx)
+			if err != nil {
+				log.Error("error getting merkleProof. Error: ", err)
+				return nil, nil, nil, fmt.Errorf("getting the proof failed, error: %v, network: %d", err, key.NetId)
+			}
+			rollupMerkleProof = emptyProof()
+		} else { // Rollup
+			var found bool
+			rollupMerkleProof, found = rollupProofs[key.NetId]
+			if !found {
+				rollupMerkleProof, rollupLeaves[key.NetId], err = s.getRollupExitProof(key.NetId-1, globalExitRoot.ExitRoots[1], dbTx)
+				if err != nil {
+					log.Error("error getting rollupProof. Error: ", err)
+					return nil, nil, nil, fmt.Errorf("getting the rollup proof failed, error: %v, network: %d", err, key.NetId)
+				}
+				rollupProofs[key.NetId] = rollupMerkleProof
+			}
+			merkleProof, err = s.getSharedProof(key.DepositCnt, rollupLeaves[key.NetId], lookups, dbTx)
+			if err != nil {
+				log.Error("error getting merkleProof. Error: ", err)
+				return nil, nil, nil, fmt.Errorf("getting the proof failed, error: %v, network: %d", err, key.NetId)
+			}
+		}
+		merkleProofs = append(merkleProofs, merkleProof)
+		rollupMerkleProofs = append(rollupMerkleProofs, rollupMerkleProof)
+	}
+
+	return globalExitRoot, merkleProofs, rollupMerkleProofs, nil
+}
+
 func emptyProof() [][bridgectrl.KeyLen]byte {
 	var proof [][bridgectrl.KeyLen]byte
 	for i := 0; i < 32; i++ {
@@ -325,6 +498,76 @@ func (s *bridgeService) CheckAPI(ctx context.Context, req *pb.CheckAPIRequest) (
 	}, nil
 }
 
+// GetSyncStatus returns the synchronization status of every network.
+// Bridge rest API endpoint
+func (s *bridgeService) GetSyncStatus(ctx context.Context, req *pb.GetSyncStatusRequest) (*pb.GetSyncStatusResponse, error) {
+	networks := make([]uint32, 0, len(s.networkIDs))
+	for networkID := range s.networkIDs {
+		networks = append(networks, networkID)
+	}
+	sort.Slice(networks, func(i, j int) bool { return s.networkIDs[networks[i]] < s.networkIDs[networks[j]] })
+
+	rollupExitLeaves, err := s.storage.GetLatestRollupExitLeaves(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []*pb.NetworkSyncStatus
+	for _, networkID := range networks {
+		status := &pb.NetworkSyncStatus{NetworkId: networkID}
+
+		lastBlock, err := s.storage.GetLastBlock(ctx, networkID, nil)
+		if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+			return nil, err
+		}
+		if lastBlock != nil {
+			status.LastSyncedBlock = lastBlock.BlockNumber
+			status.LastSyncedBlockHash = lastBlock.BlockHash.Hex()
+		}
+
+		if networkID == 0 {
+			ger, err := s.storage.GetLatestL1SyncedExitRoot(ctx, nil)
+			if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+				return nil, err
+			}
+			if err == nil {
+				status.LatestGer = ger.GlobalExitRoot.Hex()
+				// The mainnet exit root is verified once it is included in a L1 global exit root
+				status.LatestVerifiedLocalExitRoot = ger.ExitRoots[0].Hex()
+			}
+		} else {
+			ger, err := s.storage.GetLatestTrustedExitRoot(ctx, networkID, nil)
+			if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+				return nil, err
+			}
+			if err == nil {
+				status.LatestGer = ger.GlobalExitRoot.Hex()
+			}
+			for _, leaf := range rollupExitLeaves {
+				if leaf.RollupId == networkID {
+					status.LatestVerifiedLocalExitRoot = leaf.Leaf.Hex()
+				}
+			}
+		}
+
+		if s.syncStatus != nil {
+			if liveStatus, ok := s.syncStatus.GetNetworkStatus(networkID); ok {
+				status.ChainHead = liveStatus.ChainHead
+				status.Synced = liveStatus.Synced
+				status.TrustedStateSynced = liveStatus.TrustedStateSynced
+				status.StatusUpdatedAt = uint64(liveStatus.UpdatedAt.Unix())
+				if liveStatus.ChainHead > status.LastSyncedBlock {
+					status.Lag = liveStatus.ChainHead - status.LastSyncedBlock
+				}
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return &pb.GetSyncStatusResponse{
+		Networks: statuses,
+	}, nil
+}
+
 // GetBridges returns bridges for the destination address both in L1 and L2.
 // Bridge rest API endpoint
 func (s *bridgeService) GetBridges(ctx context.Context, req *pb.GetBridgesRequest) (*pb.GetBridgesResponse, error) {
@@ -452,6 +695,48 @@ func (s *bridgeService) GetProof(ctx context.Context, req *pb.GetProofRequest) (
 	}, nil
 }
 
+// GetProofs returns the merkle proofs for the given deposits, all of them built against the same global exit root.
+// Bridge rest API endpoint
+func (s *bridgeService) GetProofs(ctx context.Context, req *pb.GetProofsRequest) (*pb.GetProofsResponse, error) {
+	if len(req.Deposits) > int(s.maxPageLimit) {
+		return nil, fmt.Errorf("too many deposits requested: %d. Max: %d", len(req.Deposits), s.maxPageLimit)
+	}
+	globalExitRoot, merkleProofs, rollupMerkleProofs, err := s.GetClaimProofs(req.Deposits, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var depositProofs []*pb.DepositProof
+	for i, key := range req.Deposits {
+		var (
+			proof       []string
+			rollupProof []string
+		)
+		if len(merkleProofs[i]) != len(rollupMerkleProofs[i]) {
+			return nil, fmt.Errorf("proofs have different lengths. MerkleProof: %d. RollupMerkleProof: %d", len(merkleProofs[i]), len(rollupMerkleProofs[i]))
+		}
+		for j := 0; j < len(merkleProofs[i]); j++ {
+			proof = append(proof, "0x"+hex.EncodeToString(merkleProofs[i][j][:]))
+			rollupProof = append(rollupProof, "0x"+hex.EncodeToString(rollupMerkleProofs[i][j][:]))
+		}
+		depositProofs = append(depositProofs, &pb.DepositProof{
+			NetId:      key.NetId,
+			DepositCnt: key.DepositCnt,
+			Proof: &pb.Proof{
+				RollupMerkleProof: rollupProof,
+				MerkleProof:       proof,
+				MainExitRoot:      globalExitRoot.ExitRoots[0].Hex(),
+				RollupExitRoot:    globalExitRoot.ExitRoots[1].Hex(),
+			},
+		})
+	}
+
+	return &pb.GetProofsResponse{
+		GlobalExitRoot: globalExitRoot.GlobalExitRoot.Hex(),
+		Proofs:         depositProofs,
+	}, nil
+}
+
 // GetBridge returns the bridge  with status whether it is able to send a claim transaction or not.
 // Bridge rest API endpoint
 func (s *bridgeService) GetBridge(ctx context.Context, req *pb.GetBridgeRequest) (*pb.GetBridgeResponse, error) {
@@ -499,18 +784,68 @@ func (s *bridgeService) GetTokenWrapped(ctx context.Context, req *pb.GetTokenWra
 		return nil, err
 	}
 	return &pb.GetTokenWrappedResponse{
-		Tokenwrapped: &pb.TokenWrapped{
-			OrigNet:           uint32(tokenWrapped.OriginalNetwork),
-			OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
-			WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
-			NetworkId:         uint32(tokenWrapped.NetworkID),
-			Name:              tokenWrapped.Name,
-			Symbol:            tokenWrapped.Symbol,
-			Decimals:          uint32(tokenWrapped.Decimals),
-		},
+		Tokenwrapped: toPBTokenWrapped(tokenWrapped),
+	}, nil
+}
+
+// GetTokenByWrappedAddress returns the token wrapped created for the wrapped token address in the given network.
+// Bridge rest API endpoint
+func (s *bridgeService) GetTokenByWrappedAddress(ctx context.Context, req *pb.GetTokenByWrappedAddressRequest) (*pb.GetTokenWrappedResponse, error) {
+	if !common.IsHexAddress(req.WrappedTokenAddr) {
+		return nil, fmt.Errorf("invalid wrapped token address: %s", req.WrappedTokenAddr)
+	}
+	tokenWrapped, err := s.storage.GetTokenWrappedByAddress(ctx, req.NetworkId, common.HexToAddress(req.WrappedTokenAddr), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTokenWrappedResponse{
+		Tokenwrapped: toPBTokenWrapped(tokenWrapped),
+	}, nil
+}
+
+// ListTokensWrapped returns the wrapped tokens, optionally filtered by the network where they were
+// created and by their original network.
+// Bridge rest API endpoint
+func (s *bridgeService) ListTokensWrapped(ctx context.Context, req *pb.ListTokensWrappedRequest) (*pb.ListTokensWrappedResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit
+	}
+	if limit > s.maxPageLimit {
+		limit = s.maxPageLimit
+	}
+	totalCount, err := s.storage.GetTokensWrappedCount(ctx, req.NetworkId, req.OrigNet, nil)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.storage.GetTokensWrapped(ctx, req.NetworkId, req.OrigNet, limit, req.Offset, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbTokens []*pb.TokenWrapped
+	for _, token := range tokens {
+		pbTokens = append(pbTokens, toPBTokenWrapped(token))
+	}
+
+	return &pb.ListTokensWrappedResponse{
+		Tokenswrapped: pbTokens,
+		TotalCnt:      totalCount,
 	}, nil
 }
 
+func toPBTokenWrapped(tokenWrapped *etherman.TokenWrapped) *pb.TokenWrapped {
+	return &pb.TokenWrapped{
+		OrigNet:           uint32(tokenWrapped.OriginalNetwork),
+		OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
+		WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
+		NetworkId:         uint32(tokenWrapped.NetworkID),
+		Name:              tokenWrapped.Name,
+		Symbol:            tokenWrapped.Symbol,
+		Decimals:          uint32(tokenWrapped.Decimals),
+	}
+}
+
 func (s *bridgeService) GetProofByGER(ctx context.Context, req *pb.GetProofByGERRequest) (*pb.GetProofResponse, error) {
 	ger := common.HexToHash(req.Ger)
 	globalExitRoot, merkleProof, rollupMerkleProof, err := s.GetClaimProofbyGER(req.DepositCnt, req.NetId, ger, nil)
@@ -588,3 +923,365 @@ func (s *bridgeService) GetPendingBridgesToClaim(ctx context.Context, req *pb.Ge
 		TotalCnt: totalDeposits,
 	}, nil
 }
+
+// GetBridgeStatus returns the lifecycle status of the deposit, from the deposit to the claim in the destination network.
+// Bridge rest API endpoint
+func (s *bridgeService) GetBridgeStatus(ctx context.Context, req *pb.GetBridgeStatusRequest) (*pb.GetBridgeStatusResponse, error) {
+	deposit, err := s.storage.GetDeposit(ctx, req.DepositCnt, req.NetId, nil)
+	if err != nil {
+		return nil, err
+	}
+	claimStatus, err := s.storage.GetDepositClaimStatus(ctx, req.DepositCnt, req.NetId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	mainnetFlag := deposit.NetworkID == 0
+	var rollupIndex uint32
+	if !mainnetFlag {
+		rollupIndex = deposit.NetworkID - 1
+	}
+	localExitRootIndex := deposit.DepositCount
+	bridgeStatus := &pb.BridgeStatus{
+		Status: getBridgeStatus(deposit, claimStatus),
+		Deposit: &pb.Deposit{
+			LeafType:      uint32(deposit.LeafType),
+			OrigNet:       deposit.OriginalNetwork,
+			OrigAddr:      deposit.OriginalAddress.Hex(),
+			Amount:        deposit.Amount.String(),
+			DestNet:       deposit.DestinationNetwork,
+			DestAddr:      deposit.DestinationAddress.Hex(),
+			BlockNum:      deposit.BlockNumber,
+			DepositCnt:    deposit.DepositCount,
+			NetworkId:     deposit.NetworkID,
+			TxHash:        deposit.TxHash.String(),
+			Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
+			ReadyForClaim: deposit.ReadyForClaim,
+			GlobalIndex:   etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, localExitRootIndex).String(),
+		},
+		DepositedAt: uint64(claimStatus.DepositedAt.Unix()),
+	}
+	if claimStatus.ClaimTxHash != nil {
+		bridgeStatus.ClaimTxHash = claimStatus.ClaimTxHash.String()
+		bridgeStatus.Deposit.ClaimTxHash = bridgeStatus.ClaimTxHash
+	}
+	if claimStatus.ClaimedAt != nil {
+		bridgeStatus.ClaimedAt = uint64(claimStatus.ClaimedAt.Unix())
+	}
+	if mTx := claimStatus.MonitoredTx; mTx != nil {
+		bridgeStatus.MonitoredTxStatus = mTx.Status.String()
+		for txHash := range mTx.History {
+			bridgeStatus.MonitoredTxHashes = append(bridgeStatus.MonitoredTxHashes, txHash.String())
+		}
+		sort.Strings(bridgeStatus.MonitoredTxHashes)
+		bridgeStatus.MonitoredTxCreatedAt = uint64(mTx.CreatedAt.Unix())
+		bridgeStatus.MonitoredTxUpdatedAt = uint64(mTx.UpdatedAt.Unix())
+	}
+	if claimStatus.MonitoredTx == nil && claimStatus.RejectedReason != nil {
+		bridgeStatus.RejectedReason = *claimStatus.RejectedReason
+	}
+	if group := claimStatus.Group; group != nil {
+		bridgeStatus.GroupId = group.GroupID
+		bridgeStatus.GroupStatus = group.Status.String()
+		if group.ClaimTxHistory != nil {
+			for _, entry := range group.ClaimTxHistory.TxHashes {
+				bridgeStatus.GroupTxHashes = append(bridgeStatus.GroupTxHashes, entry.TxHash.String())
+			}
+		}
+		bridgeStatus.GroupUpdatedAt = uint64(group.UpdatedAt.Unix())
+	}
+
+	return &pb.GetBridgeStatusResponse{
+		BridgeStatus: bridgeStatus,
+	}, nil
+}
+
+// getBridgeStatus computes the lifecycle status of a deposit from the deposit itself and its claim data.
+func getBridgeStatus(deposit *etherman.Deposit, claimStatus *ctmtypes.DepositClaimStatus) string {
+	if claimStatus.ClaimTxHash != nil {
+		return BridgeStatusClaimed
+	}
+	if mTx := claimStatus.MonitoredTx; mTx != nil {
+		if claimStatus.Group != nil && claimStatus.Group.Status == ctmtypes.MonitoredTxGroupStatussFailed {
+			return BridgeStatusAutoClaimFailed
+		}
+		switch mTx.Status {
+		case ctmtypes.MonitoredTxStatusConfirmed, ctmtypes.MonitoredTxStatusClaimedExternally:
+			return BridgeStatusClaimed
+		case ctmtypes.MonitoredTxStatusFailed:
+			return BridgeStatusAutoClaimFailed
+		case ctmtypes.MonitoredTxStatusCompressing:
+			return BridgeStatusAutoClaimCompressing
+		case ctmtypes.MonitoredTxStatusClaiming:
+			return BridgeStatusAutoClaimSent
+		}
+		if len(mTx.History) > 0 {
+			return BridgeStatusAutoClaimSent
+		}
+		return BridgeStatusAutoClaimQueued
+	}
+	if claimStatus.RejectedReason != nil {
+		return BridgeStatusAutoClaimRejected
+	}
+	if deposit.ReadyForClaim {
+		return BridgeStatusReadyForClaim
+	}
+	if claimStatus.IncludedInGER {
+		return BridgeStatusWaitingGER
+	}
+	return BridgeStatusDeposited
+}
+
+// GetClaimTxData returns the destination bridge address and the calldata of the claimAsset/claimMessage call
+// that claims the specific deposit, optionally with a gas estimation of the call.
+// Bridge rest API endpoint
+func (s *bridgeService) GetClaimTxData(ctx context.Context, req *pb.GetClaimTxDataRequest) (*pb.GetClaimTxDataResponse, error) {
+	deposit, err := s.storage.GetDeposit(ctx, req.DepositCnt, req.NetId, nil)
+	if err != nil {
+		return nil, err
+	}
+	bridgeAddress, found := s.bridgeAddresses[deposit.DestinationNetwork]
+	if !found {
+		return nil, fmt.Errorf("destination network %d: %w", deposit.DestinationNetwork, gerror.ErrNetworkNotRegister)
+	}
+	globalExitRoot, merkleProof, rollupMerkleProof, err := s.GetClaimProof(req.DepositCnt, req.NetId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(merkleProof) != len(rollupMerkleProof) {
+		return nil, fmt.Errorf("proofs have different lengths. MerkleProof: %d. RollupMerkleProof: %d", len(merkleProof), len(rollupMerkleProof))
+	}
+	var (
+		smtProof       [bridgectrl.KeyLen][bridgectrl.KeyLen]byte
+		smtRollupProof [bridgectrl.KeyLen][bridgectrl.KeyLen]byte
+	)
+	for i := 0; i < len(merkleProof) && i < len(smtProof); i++ {
+		smtProof[i] = merkleProof[i]
+		smtRollupProof[i] = rollupMerkleProof[i]
+	}
+
+	mainnetFlag := deposit.NetworkID == 0
+	var rollupIndex uint32
+	if !mainnetFlag {
+		rollupIndex = deposit.NetworkID - 1
+	}
+	globalIndex := etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, deposit.DepositCount)
+
+	method := "claimAsset"
+	if deposit.LeafType == utils.LeafTypeMessage {
+		method = "claimMessage"
+	}
+	bridgeAbi, err := polygonzkevmbridgev2.Polygonzkevmbridgev2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := bridgeAbi.Pack(method, smtProof, smtRollupProof, globalIndex, globalExitRoot.ExitRoots[0], globalExitRoot.ExitRoots[1],
+		deposit.OriginalNetwork, deposit.OriginalAddress, deposit.DestinationNetwork, deposit.DestinationAddress, deposit.Amount, deposit.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error packing %s call. Error: %w", method, err)
+	}
+
+	claimTxData := &pb.ClaimTxData{
+		To:             bridgeAddress.Hex(),
+		Data:           "0x" + hex.EncodeToString(data),
+		Method:         method,
+		GlobalIndex:    globalIndex.String(),
+		GlobalExitRoot: globalExitRoot.GlobalExitRoot.Hex(),
+		MainExitRoot:   globalExitRoot.ExitRoots[0].Hex(),
+		RollupExitRoot: globalExitRoot.ExitRoots[1].Hex(),
+	}
+	if req.EstimateGas {
+		estimator, found := s.gasEstimators[deposit.DestinationNetwork]
+		if !found {
+			return nil, fmt.Errorf("gas estimation not available for network %d", deposit.DestinationNetwork)
+		}
+		gas, err := estimator.EstimateGas(ctx, ethereum.CallMsg{
+			From: common.HexToAddress(req.From),
+			To:   &bridgeAddress,
+			Data: data,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error estimating gas of the %s call. Error: %w", method, err)
+		}
+		claimTxData.Gas = gas
+	}
+
+	return &pb.GetClaimTxDataResponse{
+		ClaimTxData: claimTxData,
+	}, nil
+}
+
+// VerifyClaimProof recomputes the local, rollup and global exit roots of a claim proof and reports the level where
+// the verification fails, if any, and whether the global exit root is known and allowed in the destination network.
+// Bridge rest API endpoint
+func (s *bridgeService) VerifyClaimProof(ctx context.Context, req *pb.VerifyClaimProofRequest) (*pb.VerifyClaimProofResponse, error) {
+	var deposit *etherman.Deposit
+	if req.DepositKey != nil {
+		var err error
+		deposit, err = s.storage.GetDeposit(ctx, req.DepositKey.DepositCnt, req.DepositKey.NetId, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else if req.Deposit != nil {
+		amount, ok := new(big.Int).SetString(req.Deposit.Amount, 10) //nolint:gomnd
+		if !ok {
+			return nil, fmt.Errorf("invalid deposit amount: %s", req.Deposit.Amount)
+		}
+		deposit = &etherman.Deposit{
+			LeafType:           uint8(req.Deposit.LeafType),
+			OriginalNetwork:    req.Deposit.OrigNet,
+			OriginalAddress:    common.HexToAddress(req.Deposit.OrigAddr),
+			Amount:             amount,
+			DestinationNetwork: req.Deposit.DestNet,
+			DestinationAddress: common.HexToAddress(req.Deposit.DestAddr),
+			DepositCount:       req.Deposit.DepositCnt,
+			NetworkID:          req.Deposit.NetworkId,
+			Metadata:           common.FromHex(req.Deposit.Metadata),
+		}
+	} else {
+		return nil, fmt.Errorf("either the deposit key or the deposit leaf is required")
+	}
+	smtProof, err := decodeProof(req.SmtProof, s.height)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smt proof: %w", err)
+	}
+	smtRollupProof, err := decodeProof(req.SmtRollupProof, s.height)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smt rollup proof: %w", err)
+	}
+	// The global index is a uint256, the larger values can't be decoded
+	globalIndex, ok := new(big.Int).SetString(req.GlobalIndex, 0)
+	if !ok || globalIndex.Sign() < 0 || globalIndex.BitLen() > 256 { //nolint:gomnd
+		return nil, status.Errorf(codes.InvalidArgument, "invalid global index: %s", req.GlobalIndex)
+	}
+	mainExitRoot := common.HexToHash(req.MainExitRoot)
+	rollupExitRoot := common.HexToHash(req.RollupExitRoot)
+
+	leafHash := bridgectrl.HashDeposit(deposit)
+	globalExitRoot := common.Hash(bridgectrl.Hash(mainExitRoot, rollupExitRoot))
+	verification := &pb.ClaimProofVerification{
+		Valid:          true,
+		LeafHash:       common.BytesToHash(leafHash[:]).Hex(),
+		GlobalExitRoot: globalExitRoot.Hex(),
+	}
+	fail := func(level, reason string) {
+		verification.Valid = false
+		verification.FailedLevel = level
+		verification.Reason = reason
+	}
+
+	mainnetFlag, rollupIndex, localExitRootIndex, err := etherman.DecodeGlobalIndex(globalIndex)
+	if err != nil {
+		fail(ProofLevelGlobalIndex, err.Error())
+	} else if req.DepositKey != nil && (mainnetFlag != (deposit.NetworkID == 0) || (!mainnetFlag && rollupIndex != deposit.NetworkID-1) || localExitRootIndex != deposit.DepositCount) {
+		fail(ProofLevelGlobalIndex, fmt.Sprintf("global index %s doesn't match the deposit %d of network %d", globalIndex.String(), deposit.DepositCount, deposit.NetworkID))
+	} else {
+		localExitRoot := bridgectrl.CalculateRoot(leafHash, smtProof, uint(localExitRootIndex), s.height)
+		verification.LocalExitRoot = localExitRoot.Hex()
+		if mainnetFlag {
+			if localExitRoot != mainExitRoot {
+				fail(ProofLevelLocalExitRoot, fmt.Sprintf("computed local exit root %s doesn't match the mainnet exit root %s", localExitRoot.String(), mainExitRoot.String()))
+			}
+		} else {
+			computedRollupExitRoot := bridgectrl.CalculateRoot(localExitRoot, smtRollupProof, uint(rollupIndex), s.height)
+			verification.RollupExitRoot = computedRollupExitRoot.Hex()
+			if computedRollupExitRoot != rollupExitRoot {
+				// If the rollup exit root is known, the stored leaf tells which of both proofs is wrong
+				level := ProofLevelRollupExitRoot
+				leaves, err := s.storage.GetRollupExitLeavesByRoot(ctx, rollupExitRoot, nil)
+				if err == nil {
+					for _, l := range leaves {
+						if l.RollupId == rollupIndex+1 && l.Leaf != localExitRoot {
+							level = ProofLevelLocalExitRoot
+						}
+					}
+				}
+				fail(level, fmt.Sprintf("computed rollup exit root %s doesn't match the rollup exit root %s", computedRollupExitRoot.String(), rollupExitRoot.String()))
+			}
+		}
+	}
+
+	allowed, err := s.storage.GetGERAllowed(ctx, globalExitRoot, deposit.DestinationNetwork, nil)
+	if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+		return nil, err
+	}
+	verification.GerKnown = err == nil
+	verification.GerAllowed = allowed
+
+	return &pb.VerifyClaimProofResponse{
+		Verification: verification,
+	}, nil
+}
+
+// GetClaimCosts returns the cost of the claims sent by the claim tx manager per day, token, destination
+// network and signer.
+// Bridge rest API endpoint
+func (s *bridgeService) GetClaimCosts(ctx context.Context, req *pb.GetClaimCostsRequest) (*pb.GetClaimCostsResponse, error) {
+	from, to, err := ClaimCostsRange(req.From, req.To, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	costs, err := s.storage.GetClaimCosts(ctx, from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+	var pbCosts []*pb.ClaimCost
+	for _, cost := range costs {
+		pbCosts = append(pbCosts, toPBClaimCost(cost))
+	}
+	return &pb.GetClaimCostsResponse{
+		Costs: pbCosts,
+	}, nil
+}
+
+// ClaimCostsRange returns the time range [from, to) of the claim costs report between the from and to days,
+// both included, formatted as YYYY-MM-DD in UTC. By default the report covers the last 30 days until now.
+func ClaimCostsRange(fromDay, toDay string, now time.Time) (time.Time, time.Time, error) {
+	to := now.UTC().Truncate(24 * time.Hour)
+	if toDay != "" {
+		var err error
+		if to, err = time.Parse(claimCostsDayLayout, toDay); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to day %s: %w", toDay, err)
+		}
+	}
+	from := to.AddDate(0, 0, -claimCostsDefaultDays+1)
+	if fromDay != "" {
+		var err error
+		if from, err = time.Parse(claimCostsDayLayout, fromDay); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from day %s: %w", fromDay, err)
+		}
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("the from day %s is after the to day %s", from.Format(claimCostsDayLayout), to.Format(claimCostsDayLayout))
+	}
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+func toPBClaimCost(cost *ctmtypes.ClaimCost) *pb.ClaimCost {
+	fee := "0"
+	if cost.Fee != nil {
+		fee = cost.Fee.String()
+	}
+	return &pb.ClaimCost{
+		Day:      cost.Day.UTC().Format(claimCostsDayLayout),
+		OrigNet:  cost.OriginalNetwork,
+		OrigAddr: cost.OriginalAddress.Hex(),
+		DestNet:  cost.DestinationNetwork,
+		Signer:   cost.Signer.Hex(),
+		Claims:   cost.Claims,
+		GasUsed:  cost.GasUsed,
+		Fee:      fee,
+	}
+}
+
+// decodeProof decodes the hex encoded siblings of a merkle proof.
+func decodeProof(proof []string, height uint8) ([][bridgectrl.KeyLen]byte, error) {
+	if len(proof) != int(height) {
+		return nil, fmt.Errorf("wrong proof length. Expected: %d, got: %d", height, len(proof))
+	}
+	siblings := make([][bridgectrl.KeyLen]byte, 0, len(proof))
+	for _, p := range proof {
+		siblings = append(siblings, common.HexToHash(p))
+	}
+	return siblings, nil
+}
@@ -1,12 +1,24 @@
 package server
 
 import (
+	"context"
+	"math/big"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/fiwallets/zkevm-bridge-service/bridgectrl"
+	"github.com/fiwallets/zkevm-bridge-service/bridgectrl/pb"
+	ctmtypes "github.com/fiwallets/zkevm-bridge-service/claimtxman/types"
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
+	"github.com/fiwallets/zkevm-bridge-service/etherman/smartcontracts/polygonzkevmbridgev2"
+	"github.com/fiwallets/zkevm-bridge-service/synchronizer"
+	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetClaimProofbyGER(t *testing.T) {
@@ -34,3 +46,445 @@ func TestGetClaimProofbyGER(t *testing.T) {
 	require.NotNil(t, smtRollupProof)
 	require.NotNil(t, globaExitRoot)
 }
+
+func TestGetBridgeStatus(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	deposit := &etherman.Deposit{
+		NetworkID:          0,
+		DestinationNetwork: 1,
+		DepositCount:       3,
+		Amount:             big.NewInt(100),
+		ReadyForClaim:      true,
+	}
+	groupID := uint64(2)
+	txHash := common.HexToHash("0x01")
+	now := time.Now()
+	claimStatus := &ctmtypes.DepositClaimStatus{
+		DepositedAt: now,
+		MonitoredTx: &ctmtypes.MonitoredTx{
+			Status:    ctmtypes.MonitoredTxStatusClaiming,
+			History:   map[common.Hash]bool{},
+			CreatedAt: now,
+			UpdatedAt: now,
+			GroupID:   &groupID,
+		},
+		Group: &ctmtypes.MonitoredTxGroupDBEntry{
+			GroupID:        groupID,
+			Status:         ctmtypes.MonitoredTxGroupStatusClaiming,
+			ClaimTxHistory: &ctmtypes.TxHistoryV2{TxHashes: []ctmtypes.TxHashHistoryEntry{{TxHash: txHash}}},
+			UpdatedAt:      now,
+		},
+	}
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(3), uint32(0), mock.Anything).Return(deposit, nil)
+	mockStorage.EXPECT().GetDepositClaimStatus(mock.Anything, uint32(3), uint32(0), mock.Anything).Return(claimStatus, nil)
+
+	res, err := sut.GetBridgeStatus(context.Background(), &pb.GetBridgeStatusRequest{NetId: 0, DepositCnt: 3})
+	require.NoError(t, err)
+	require.Equal(t, BridgeStatusAutoClaimSent, res.BridgeStatus.Status)
+	require.Equal(t, groupID, res.BridgeStatus.GroupId)
+	require.Equal(t, []string{txHash.String()}, res.BridgeStatus.GroupTxHashes)
+	require.Equal(t, uint64(now.Unix()), res.BridgeStatus.DepositedAt)
+	require.Equal(t, "", res.BridgeStatus.ClaimTxHash)
+}
+
+func TestGetBridgeStatusTransitions(t *testing.T) {
+	claimTxHash := common.HexToHash("0x02")
+	rejectedReason := "token is not sponsored"
+	testCases := []struct {
+		name          string
+		readyForClaim bool
+		claimStatus   ctmtypes.DepositClaimStatus
+		expected      string
+	}{
+		{"deposited", false, ctmtypes.DepositClaimStatus{}, BridgeStatusDeposited},
+		{"waiting ger", false, ctmtypes.DepositClaimStatus{IncludedInGER: true}, BridgeStatusWaitingGER},
+		{"ready for claim", true, ctmtypes.DepositClaimStatus{IncludedInGER: true}, BridgeStatusReadyForClaim},
+		{"autoclaim queued", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusCreated}}, BridgeStatusAutoClaimQueued},
+		{"autoclaim sent", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusCreated, History: map[common.Hash]bool{{}: true}}}, BridgeStatusAutoClaimSent},
+		{"autoclaim compressing", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusCompressing}}, BridgeStatusAutoClaimCompressing},
+		{"autoclaim failed", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusFailed}}, BridgeStatusAutoClaimFailed},
+		{"autoclaim group failed", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusClaiming}, Group: &ctmtypes.MonitoredTxGroupDBEntry{Status: ctmtypes.MonitoredTxGroupStatussFailed}}, BridgeStatusAutoClaimFailed},
+		{"autoclaim confirmed", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusConfirmed}}, BridgeStatusClaimed},
+		{"autoclaim claimed externally", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusClaimedExternally}}, BridgeStatusClaimed},
+		{"autoclaim rejected", true, ctmtypes.DepositClaimStatus{IncludedInGER: true, RejectedReason: &rejectedReason}, BridgeStatusAutoClaimRejected},
+		{"autoclaim queued after a rejection", true, ctmtypes.DepositClaimStatus{MonitoredTx: &ctmtypes.MonitoredTx{Status: ctmtypes.MonitoredTxStatusCreated}, RejectedReason: &rejectedReason}, BridgeStatusAutoClaimQueued},
+		{"claimed after a rejection", true, ctmtypes.DepositClaimStatus{ClaimTxHash: &claimTxHash, RejectedReason: &rejectedReason}, BridgeStatusClaimed},
+		{"claimed", true, ctmtypes.DepositClaimStatus{ClaimTxHash: &claimTxHash}, BridgeStatusClaimed},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deposit := &etherman.Deposit{ReadyForClaim: tc.readyForClaim}
+			require.Equal(t, tc.expected, getBridgeStatus(deposit, &tc.claimStatus))
+		})
+	}
+}
+
+func TestGetProofs(t *testing.T) {
+	cfg := Config{
+		CacheSize:    32,
+		MaxPageLimit: 10,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	ger := common.HexToHash("0x01")
+	exitRoot := etherman.GlobalExitRoot{
+		GlobalExitRoot: ger,
+		ExitRoots:      []common.Hash{{}, {}},
+	}
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(1), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+	mockStorage.EXPECT().GetLatestExitRoot(mock.Anything, uint32(0), uint32(1), mock.Anything).Return(&exitRoot, nil).Once()
+	node := [][]byte{{}, {}}
+	mockStorage.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(node, nil).Once()
+
+	res, err := sut.GetProofs(context.Background(), &pb.GetProofsRequest{
+		Deposits: []*pb.DepositKey{{NetId: 0, DepositCnt: 1}, {NetId: 0, DepositCnt: 2}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ger.Hex(), res.GlobalExitRoot)
+	require.Equal(t, 2, len(res.Proofs))
+	require.Equal(t, uint32(2), res.Proofs[1].DepositCnt)
+	require.Equal(t, 32, len(res.Proofs[0].Proof.MerkleProof))
+	require.Equal(t, 32, len(res.Proofs[1].Proof.RollupMerkleProof))
+}
+
+func TestGetProofsDifferentDestinations(t *testing.T) {
+	cfg := Config{
+		CacheSize:    32,
+		MaxPageLimit: 10,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1, 2}, mockStorage)
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(1), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 2, ReadyForClaim: true}, nil).Once()
+
+	_, err := sut.GetProofs(context.Background(), &pb.GetProofsRequest{
+		Deposits: []*pb.DepositKey{{NetId: 0, DepositCnt: 1}, {NetId: 0, DepositCnt: 2}},
+	})
+	require.Error(t, err)
+}
+
+func TestGetProofsMixedNetworks(t *testing.T) {
+	cfg := Config{
+		CacheSize:    32,
+		MaxPageLimit: 10,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1, 2}, mockStorage)
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(1), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(2), mock.Anything).Return(&etherman.Deposit{NetworkID: 2, DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+
+	// The rollup deposits can't use the GER synced in the destination network for the mainnet deposits
+	_, err := sut.GetProofs(context.Background(), &pb.GetProofsRequest{
+		Deposits: []*pb.DepositKey{{NetId: 0, DepositCnt: 1}, {NetId: 2, DepositCnt: 2}},
+	})
+	require.Error(t, err)
+
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(2), mock.Anything).Return(&etherman.Deposit{NetworkID: 2, DestinationNetwork: 1, ReadyForClaim: true}, nil).Once()
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(3), uint32(2), mock.Anything).Return(&etherman.Deposit{NetworkID: 2, DestinationNetwork: 0, ReadyForClaim: true}, nil).Once()
+
+	// The rollup deposits must have the same destination network too
+	_, err = sut.GetProofs(context.Background(), &pb.GetProofsRequest{
+		Deposits: []*pb.DepositKey{{NetId: 2, DepositCnt: 2}, {NetId: 2, DepositCnt: 3}},
+	})
+	require.Error(t, err)
+}
+
+func TestGetClaimTxData(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	mockGasEstimator := newGasEstimatorMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	bridgeAddress := common.HexToAddress("0x2a3DD3EB832aF982ec71669E178424b10Dca2EDe")
+	sut.RegisterNetwork(1, bridgeAddress, mockGasEstimator)
+
+	deposit := &etherman.Deposit{
+		LeafType:           0,
+		OriginalNetwork:    0,
+		Amount:             big.NewInt(100),
+		DestinationNetwork: 1,
+		DestinationAddress: common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"),
+		DepositCount:       2,
+		ReadyForClaim:      true,
+	}
+	exitRoot := etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x01"),
+		ExitRoots:      []common.Hash{{}, {}},
+	}
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(0), mock.Anything).Return(deposit, nil)
+	mockStorage.EXPECT().GetLatestExitRoot(mock.Anything, uint32(0), uint32(1), mock.Anything).Return(&exitRoot, nil)
+	node := [][]byte{{}, {}}
+	mockStorage.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(node, nil)
+	mockGasEstimator.EXPECT().EstimateGas(mock.Anything, mock.Anything).Return(uint64(150000), nil)
+
+	res, err := sut.GetClaimTxData(context.Background(), &pb.GetClaimTxDataRequest{NetId: 0, DepositCnt: 2, EstimateGas: true})
+	require.NoError(t, err)
+	require.Equal(t, bridgeAddress.Hex(), res.ClaimTxData.To)
+	require.Equal(t, "claimAsset", res.ClaimTxData.Method)
+	require.Equal(t, etherman.GenerateGlobalIndex(true, 0, 2).String(), res.ClaimTxData.GlobalIndex)
+	require.Equal(t, uint64(150000), res.ClaimTxData.Gas)
+
+	bridgeAbi, err := polygonzkevmbridgev2.Polygonzkevmbridgev2MetaData.GetAbi()
+	require.NoError(t, err)
+	data := common.FromHex(res.ClaimTxData.Data)
+	require.Equal(t, bridgeAbi.Methods["claimAsset"].ID, data[:4])
+	args, err := bridgeAbi.Methods["claimAsset"].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Equal(t, etherman.GenerateGlobalIndex(true, 0, 2).String(), args[2].(*big.Int).String())
+	require.Equal(t, deposit.DestinationAddress, args[8])
+}
+
+func TestGetClaimTxDataNetworkNotRegistered(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(2), uint32(0), mock.Anything).Return(&etherman.Deposit{DestinationNetwork: 1}, nil)
+
+	_, err := sut.GetClaimTxData(context.Background(), &pb.GetClaimTxDataRequest{NetId: 0, DepositCnt: 2})
+	require.ErrorIs(t, err, gerror.ErrNetworkNotRegister)
+}
+
+func TestVerifyClaimProof(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+
+	deposit := &etherman.Deposit{
+		LeafType:           0,
+		OriginalNetwork:    0,
+		OriginalAddress:    common.Address{},
+		Amount:             big.NewInt(100),
+		DestinationNetwork: 1,
+		DestinationAddress: common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"),
+		DepositCount:       0,
+		NetworkID:          0,
+	}
+	// Tree with a single leaf: every sibling is the zero hash of its level
+	var (
+		smtProof       []string
+		smtRollupProof []string
+		siblings       [][bridgectrl.KeyLen]byte
+		zero           [bridgectrl.KeyLen]byte
+	)
+	for i := 0; i < 32; i++ {
+		siblings = append(siblings, zero)
+		smtProof = append(smtProof, common.BytesToHash(zero[:]).Hex())
+		smtRollupProof = append(smtRollupProof, common.Hash{}.Hex())
+		zero = bridgectrl.Hash(zero, zero)
+	}
+	mainExitRoot := bridgectrl.CalculateRoot(bridgectrl.HashDeposit(deposit), siblings, 0, 32)
+	rollupExitRoot := common.HexToHash("0x01")
+	ger := common.Hash(bridgectrl.Hash(mainExitRoot, rollupExitRoot))
+	mockStorage.EXPECT().GetDeposit(mock.Anything, uint32(0), uint32(0), mock.Anything).Return(deposit, nil)
+	mockStorage.EXPECT().GetGERAllowed(mock.Anything, ger, uint32(1), mock.Anything).Return(true, nil).Once()
+
+	req := &pb.VerifyClaimProofRequest{
+		DepositKey:     &pb.DepositKey{NetId: 0, DepositCnt: 0},
+		SmtProof:       smtProof,
+		SmtRollupProof: smtRollupProof,
+		GlobalIndex:    etherman.GenerateGlobalIndex(true, 0, 0).String(),
+		MainExitRoot:   mainExitRoot.Hex(),
+		RollupExitRoot: rollupExitRoot.Hex(),
+	}
+	res, err := sut.VerifyClaimProof(context.Background(), req)
+	require.NoError(t, err)
+	require.True(t, res.Verification.Valid)
+	require.Equal(t, mainExitRoot.Hex(), res.Verification.LocalExitRoot)
+	require.Equal(t, ger.Hex(), res.Verification.GlobalExitRoot)
+	require.True(t, res.Verification.GerKnown)
+	require.True(t, res.Verification.GerAllowed)
+
+	// Wrong global index
+	mockStorage.EXPECT().GetGERAllowed(mock.Anything, ger, uint32(1), mock.Anything).Return(false, gerror.ErrStorageNotFound).Once()
+	req.GlobalIndex = etherman.GenerateGlobalIndex(true, 0, 1).String()
+	res, err = sut.VerifyClaimProof(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, res.Verification.Valid)
+	require.Equal(t, ProofLevelGlobalIndex, res.Verification.FailedLevel)
+	require.False(t, res.Verification.GerKnown)
+
+	// Wrong smt proof
+	mockStorage.EXPECT().GetGERAllowed(mock.Anything, ger, uint32(1), mock.Anything).Return(false, nil).Once()
+	req.GlobalIndex = etherman.GenerateGlobalIndex(true, 0, 0).String()
+	req.SmtProof[3] = common.HexToHash("0x02").Hex()
+	res, err = sut.VerifyClaimProof(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, res.Verification.Valid)
+	require.Equal(t, ProofLevelLocalExitRoot, res.Verification.FailedLevel)
+	require.True(t, res.Verification.GerKnown)
+	require.False(t, res.Verification.GerAllowed)
+
+	// The global indexes that aren't a uint256 are rejected
+	for _, globalIndex := range []string{"-1", "0x1" + strings.Repeat("0", 64), "one"} {
+		req.GlobalIndex = globalIndex
+		_, err = sut.VerifyClaimProof(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestListTokensWrapped(t *testing.T) {
+	cfg := Config{
+		CacheSize:        32,
+		DefaultPageLimit: 25,
+		MaxPageLimit:     100,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	networkID := uint32(1)
+	token := &etherman.TokenWrapped{
+		TokenMetadata: etherman.TokenMetadata{
+			Name:     "Wrapped Ether",
+			Symbol:   "WETH",
+			Decimals: 18,
+		},
+		OriginalNetwork:      0,
+		OriginalTokenAddress: common.HexToAddress("0x01"),
+		WrappedTokenAddress:  common.HexToAddress("0x02"),
+		NetworkID:            1,
+	}
+	mockStorage.EXPECT().GetTokensWrappedCount(mock.Anything, &networkID, (*uint32)(nil), mock.Anything).Return(uint64(1), nil)
+	mockStorage.EXPECT().GetTokensWrapped(mock.Anything, &networkID, (*uint32)(nil), uint32(100), uint32(0), mock.Anything).Return([]*etherman.TokenWrapped{token}, nil)
+
+	res, err := sut.ListTokensWrapped(context.Background(), &pb.ListTokensWrappedRequest{NetworkId: &networkID, Limit: 1000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.TotalCnt)
+	require.Len(t, res.Tokenswrapped, 1)
+	require.Equal(t, token.WrappedTokenAddress.Hex(), res.Tokenswrapped[0].WrappedTokenAddr)
+	require.Equal(t, "WETH", res.Tokenswrapped[0].Symbol)
+	require.Equal(t, uint32(18), res.Tokenswrapped[0].Decimals)
+}
+
+func TestGetTokenByWrappedAddress(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	wrappedAddr := common.HexToAddress("0x02")
+	token := &etherman.TokenWrapped{
+		OriginalNetwork:      0,
+		OriginalTokenAddress: common.HexToAddress("0x01"),
+		WrappedTokenAddress:  wrappedAddr,
+		NetworkID:            1,
+	}
+	mockStorage.EXPECT().GetTokenWrappedByAddress(mock.Anything, uint32(1), wrappedAddr, mock.Anything).Return(token, nil)
+
+	res, err := sut.GetTokenByWrappedAddress(context.Background(), &pb.GetTokenByWrappedAddressRequest{WrappedTokenAddr: wrappedAddr.Hex(), NetworkId: 1})
+	require.NoError(t, err)
+	require.Equal(t, token.OriginalTokenAddress.Hex(), res.Tokenwrapped.OriginalTokenAddr)
+
+	_, err = sut.GetTokenByWrappedAddress(context.Background(), &pb.GetTokenByWrappedAddressRequest{WrappedTokenAddr: "0xzz", NetworkId: 1})
+	require.Error(t, err)
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	mockTracker := newSyncStatusTrackerMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	sut.SetSyncStatusTracker(mockTracker)
+
+	l1GER := &etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x10"),
+		ExitRoots:      []common.Hash{common.HexToHash("0x11"), common.HexToHash("0x12")},
+	}
+	l2GER := &etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x20"),
+		NetworkID:      1,
+	}
+	leaves := []etherman.RollupExitLeaf{{RollupId: 1, Leaf: common.HexToHash("0x21"), Root: common.HexToHash("0x12")}}
+	mockStorage.EXPECT().GetLatestRollupExitLeaves(mock.Anything, mock.Anything).Return(leaves, nil)
+	mockStorage.EXPECT().GetLastBlock(mock.Anything, uint32(0), mock.Anything).Return(&etherman.Block{BlockNumber: 90, BlockHash: common.HexToHash("0x01")}, nil)
+	mockStorage.EXPECT().GetLastBlock(mock.Anything, uint32(1), mock.Anything).Return(nil, gerror.ErrStorageNotFound)
+	mockStorage.EXPECT().GetLatestL1SyncedExitRoot(mock.Anything, mock.Anything).Return(l1GER, nil)
+	mockStorage.EXPECT().GetLatestTrustedExitRoot(mock.Anything, uint32(1), mock.Anything).Return(l2GER, nil)
+	updatedAt := time.Now()
+	mockTracker.EXPECT().GetNetworkStatus(uint32(0)).Return(synchronizer.NetworkStatus{ChainHead: 100, UpdatedAt: updatedAt}, true)
+	mockTracker.EXPECT().GetNetworkStatus(uint32(1)).Return(synchronizer.NetworkStatus{}, false)
+
+	res, err := sut.GetSyncStatus(context.Background(), &pb.GetSyncStatusRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Networks, 2)
+
+	l1 := res.Networks[0]
+	require.Equal(t, uint32(0), l1.NetworkId)
+	require.Equal(t, uint64(90), l1.LastSyncedBlock)
+	require.Equal(t, common.HexToHash("0x01").Hex(), l1.LastSyncedBlockHash)
+	require.Equal(t, uint64(100), l1.ChainHead)
+	require.Equal(t, uint64(10), l1.Lag)
+	require.False(t, l1.Synced)
+	require.Equal(t, l1GER.GlobalExitRoot.Hex(), l1.LatestGer)
+	require.Equal(t, l1GER.ExitRoots[0].Hex(), l1.LatestVerifiedLocalExitRoot)
+	require.Equal(t, uint64(updatedAt.Unix()), l1.StatusUpdatedAt)
+
+	l2 := res.Networks[1]
+	require.Equal(t, uint32(1), l2.NetworkId)
+	require.Equal(t, uint64(0), l2.LastSyncedBlock)
+	require.Equal(t, uint64(0), l2.ChainHead)
+	require.Equal(t, l2GER.GlobalExitRoot.Hex(), l2.LatestGer)
+	require.Equal(t, leaves[0].Leaf.Hex(), l2.LatestVerifiedLocalExitRoot)
+	require.Equal(t, uint64(0), l2.StatusUpdatedAt)
+}
+
+func TestGetClaimCosts(t *testing.T) {
+	cfg := Config{
+		CacheSize: 32,
+	}
+	mockStorage := newBridgeServiceStorageMock(t)
+	sut := NewBridgeService(cfg, 32, []uint32{0, 1}, mockStorage)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)
+	cost := &ctmtypes.ClaimCost{
+		Day:                from,
+		OriginalNetwork:    0,
+		OriginalAddress:    common.HexToAddress("0x01"),
+		DestinationNetwork: 1,
+		Signer:             common.HexToAddress("0x02"),
+		Claims:             3,
+		GasUsed:            300000,
+		Fee:                big.NewInt(3000000),
+	}
+	// The to day is included
+	mockStorage.EXPECT().GetClaimCosts(mock.Anything, from, to, mock.Anything).Return([]*ctmtypes.ClaimCost{cost}, nil)
+
+	res, err := sut.GetClaimCosts(context.Background(), &pb.GetClaimCostsRequest{From: "2024-03-01", To: "2024-03-02"})
+	require.NoError(t, err)
+	require.Len(t, res.Costs, 1)
+	require.Equal(t, "2024-03-01", res.Costs[0].Day)
+	require.Equal(t, cost.OriginalAddress.Hex(), res.Costs[0].OrigAddr)
+	require.Equal(t, uint32(1), res.Costs[0].DestNet)
+	require.Equal(t, cost.Signer.Hex(), res.Costs[0].Signer)
+	require.Equal(t, uint64(3), res.Costs[0].Claims)
+	require.Equal(t, uint64(300000), res.Costs[0].GasUsed)
+	require.Equal(t, "3000000", res.Costs[0].Fee)
+
+	_, err = sut.GetClaimCosts(context.Background(), &pb.GetClaimCostsRequest{From: "2024-03-02", To: "2024-03-01"})
+	require.Error(t, err)
+	_, err = sut.GetClaimCosts(context.Background(), &pb.GetClaimCostsRequest{From: "03/01/2024"})
+	require.Error(t, err)
+}
+
+func TestClaimCostsRange(t *testing.T) {
+	now := time.Date(2024, 3, 31, 15, 4, 5, 0, time.UTC)
+	from, to, err := ClaimCostsRange("", "", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), to)
+
+	from, to, err = ClaimCostsRange("", "2024-02-10", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, time.Date(2024, 2, 11, 0, 0, 0, 0, time.UTC), to)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serverTLS are the TLS settings of the servers and of the gateway connection to the gRPC server.
+type serverTLS struct {
+	// grpcServer is the TLS config of the gRPC server, nil when TLS is disabled
+	grpcServer *tls.Config
+	// restServer is the TLS config of the HTTP/REST gateway, nil when TLS is disabled
+	restServer *tls.Config
+	// gatewayDial are the credentials used by the gateway to connect to the gRPC server
+	gatewayDial credentials.TransportCredentials
+}
+
+// newServerTLS loads the certificates of the TLS config. Plaintext is used when TLS is disabled.
+func newServerTLS(cfg TLSConfig) (*serverTLS, error) {
+	if !cfg.Enabled {
+		return &serverTLS{gatewayDial: insecure.NewCredentials()}, nil
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the server certificate: %w", err)
+	}
+	grpcServer := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	restServer := grpcServer.Clone()
+	gatewayDial := &tls.Config{
+		ServerName: cfg.GatewayServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.GatewayCAFile != "" {
+		gatewayDial.RootCAs, err = loadCertPool(cfg.GatewayCAFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.ClientCAFile != "" {
+		grpcServer.ClientCAs, err = loadCertPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		grpcServer.ClientAuth = tls.RequireAndVerifyClientCert
+		gatewayCert := cert
+		if cfg.GatewayCertFile != "" {
+			gatewayCert, err = tls.LoadX509KeyPair(cfg.GatewayCertFile, cfg.GatewayKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("error loading the gateway client certificate: %w", err)
+			}
+		}
+		gatewayDial.Certificates = []tls.Certificate{gatewayCert}
+	}
+	return &serverTLS{
+		grpcServer:  grpcServer,
+		restServer:  restServer,
+		gatewayDial: credentials.NewTLS(gatewayDial),
+	}, nil
+}
+
+// grpcServerOptions returns the gRPC server options to serve over TLS, if enabled.
+func (t *serverTLS) grpcServerOptions() []grpc.ServerOption {
+	if t.grpcServer == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(t.grpcServer))}
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the CA file %s: %w", file, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificates found in the CA file %s", file)
+	}
+	return pool, nil
+}
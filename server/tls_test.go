@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+func newTestCert(t *testing.T, dir, name string, serial int64, isCA bool, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	c := &testCert{
+		cert:     cert,
+		key:      key,
+		certFile: filepath.Join(dir, name+".crt"),
+		keyFile:  filepath.Join(dir, name+".key"),
+	}
+	require.NoError(t, os.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return c
+}
+
+func startTestGRPCServer(t *testing.T, serverTLS *serverTLS) string {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer(serverTLS.grpcServerOptions()...)
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	go func() {
+		_ = s.Serve(listen)
+	}()
+	t.Cleanup(s.Stop)
+	return listen.Addr().String()
+}
+
+func checkHealth(addr string, creds credentials.TransportCredentials) error {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestServerTLSDisabled(t *testing.T) {
+	serverTLS, err := newServerTLS(TLSConfig{Enabled: false, CertFile: "missing.crt"})
+	require.NoError(t, err)
+	require.Nil(t, serverTLS.grpcServer)
+	require.Nil(t, serverTLS.restServer)
+	require.Empty(t, serverTLS.grpcServerOptions())
+	require.Equal(t, "insecure", serverTLS.gatewayDial.Info().SecurityProtocol)
+
+	addr := startTestGRPCServer(t, serverTLS)
+	require.NoError(t, checkHealth(addr, serverTLS.gatewayDial))
+}
+
+func TestServerTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", 1, true, nil)
+	serverCert := newTestCert(t, dir, "server", 2, false, ca)
+	gatewayCert := newTestCert(t, dir, "gateway", 3, false, ca)
+	otherCA := newTestCert(t, dir, "other-ca", 4, true, nil)
+	otherClientCert := newTestCert(t, dir, "other-client", 5, false, otherCA)
+
+	_, err := newServerTLS(TLSConfig{Enabled: true, CertFile: filepath.Join(dir, "missing.crt"), KeyFile: serverCert.keyFile})
+	require.Error(t, err)
+	_, err = newServerTLS(TLSConfig{Enabled: true, CertFile: serverCert.certFile, KeyFile: serverCert.keyFile, ClientCAFile: serverCert.keyFile})
+	require.Error(t, err)
+
+	t.Run("TLS", func(t *testing.T) {
+		serverTLS, err := newServerTLS(TLSConfig{
+			Enabled:       true,
+			CertFile:      serverCert.certFile,
+			KeyFile:       serverCert.keyFile,
+			GatewayCAFile: ca.certFile,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, serverTLS.restServer)
+		require.Equal(t, tls.NoClientCert, serverTLS.grpcServer.ClientAuth)
+		addr := startTestGRPCServer(t, serverTLS)
+
+		require.NoError(t, checkHealth(addr, serverTLS.gatewayDial))
+		// The server certificate is not trusted without the CA
+		require.Error(t, checkHealth(addr, credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})))
+	})
+
+	t.Run("mutual TLS", func(t *testing.T) {
+		serverTLS, err := newServerTLS(TLSConfig{
+			Enabled:           true,
+			CertFile:          serverCert.certFile,
+			KeyFile:           serverCert.keyFile,
+			ClientCAFile:      ca.certFile,
+			GatewayCAFile:     ca.certFile,
+			GatewayServerName: "localhost",
+			GatewayCertFile:   gatewayCert.certFile,
+			GatewayKeyFile:    gatewayCert.keyFile,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tls.RequireAndVerifyClientCert, serverTLS.grpcServer.ClientAuth)
+		require.Equal(t, tls.NoClientCert, serverTLS.restServer.ClientAuth)
+		addr := startTestGRPCServer(t, serverTLS)
+
+		require.NoError(t, checkHealth(addr, serverTLS.gatewayDial))
+
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.cert)
+		// Clients without certificate are rejected
+		require.Error(t, checkHealth(addr, credentials.NewTLS(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12})))
+		// Clients with a certificate signed by an unknown CA are rejected
+		otherClient, err := tls.LoadX509KeyPair(otherClientCert.certFile, otherClientCert.keyFile)
+		require.NoError(t, err)
+		require.Error(t, checkHealth(addr, credentials.NewTLS(&tls.Config{
+			RootCAs:      roots,
+			Certificates: []tls.Certificate{otherClient},
+			MinVersion:   tls.VersionTLS12,
+		})))
+	})
+}
@@ -0,0 +1,12 @@
+package shutdown
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
+// Config represents the configuration of the graceful shutdown
+type Config struct {
+	// Timeout is the maximum time to stop the service gracefully. The components still running
+	// when it expires are aborted
+	Timeout types.Duration `mapstructure:"Timeout"`
+}
@@ -0,0 +1,171 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fiwallets/zkevm-bridge-service/log"
+)
+
+// Stage is a group of components stopped together. The stages are stopped in order, so a stage
+// can rely on the components of the next stages while it stops.
+type Stage int
+
+const (
+	// StageIntake stops accepting new work, like the API requests, and drains the work in flight
+	StageIntake Stage = iota
+	// StageSync stops the synchronizers once they finish the block being processed
+	StageSync
+	// StageClaimTxManager stops the claim tx managers once their state is persisted
+	StageClaimTxManager
+
+	stagesCount = int(StageClaimTxManager) + 1
+)
+
+func (s Stage) String() string {
+	switch s {
+	case StageIntake:
+		return "intake"
+	case StageSync:
+		return "sync"
+	case StageClaimTxManager:
+		return "claimtxman"
+	}
+	return fmt.Sprintf("stage-%d", int(s))
+}
+
+// StopFunc stops a component and blocks until it is stopped or the context is done
+type StopFunc func(ctx context.Context) error
+
+type component struct {
+	name string
+	stop StopFunc
+}
+
+// Coordinator stops the components of the service in stages when the process receives a
+// termination signal. The whole shutdown is bounded by the configured timeout.
+type Coordinator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+
+	mu         sync.Mutex
+	components [stagesCount][]component
+}
+
+// NewCoordinator creates a new shutdown coordinator.
+func NewCoordinator(parentCtx context.Context, cfg Config) *Coordinator {
+	ctx, cancel := context.WithCancel(parentCtx)
+	return &Coordinator{
+		ctx:     ctx,
+		cancel:  cancel,
+		timeout: cfg.Timeout.Duration,
+	}
+}
+
+// Context returns the context of the service components. It is cancelled at the end of the
+// shutdown, aborting the components that didn't stop in time.
+func (c *Coordinator) Context() context.Context {
+	return c.ctx
+}
+
+// Register adds a component to be stopped in the stage.
+func (c *Coordinator) Register(stage Stage, name string, stop StopFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.components[stage] = append(c.components[stage], component{name: name, stop: stop})
+}
+
+// Wait blocks until the process receives SIGINT or SIGTERM, or the context is cancelled, and
+// then shuts the service down.
+func (c *Coordinator) Wait() error {
+	ctx, stop := signal.NotifyContext(c.ctx, os.Interrupt, syscall.SIGTERM)
+	<-ctx.Done()
+	stop()
+	log.Info("Shutting down...")
+	return c.Shutdown()
+}
+
+// Shutdown stops the stages in order. The components of a stage are stopped concurrently. When
+// the timeout expires the remaining components are not waited for and the context is cancelled.
+func (c *Coordinator) Shutdown() error {
+	defer c.cancel()
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	c.mu.Lock()
+	components := c.components
+	c.mu.Unlock()
+
+	var errs []error
+	for stage, stageComponents := range components {
+		if err := stopStage(ctx, Stage(stage), stageComponents); err != nil {
+			errs = append(errs, err)
+		}
+		if ctx.Err() != nil {
+			errs = append(errs, fmt.Errorf("shutdown timed out after %s", c.timeout))
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	log.Info("Shutdown completed")
+	return nil
+}
+
+// stopStage stops the components of a stage and waits until all of them are stopped or the
+// context is done.
+func stopStage(ctx context.Context, stage Stage, components []component) error {
+	if len(components) == 0 {
+		return nil
+	}
+	log.Infof("Stopping %s stage", stage)
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(components))
+	for _, comp := range components {
+		go func(comp component) {
+			results <- result{name: comp.name, err: comp.stop(ctx)}
+		}(comp)
+	}
+	var errs []error
+	for pending := len(components); pending > 0; pending-- {
+		select {
+		case r := <-results:
+			if r.err != nil {
+				log.Errorf("error stopping %s: %v", r.name, r.err)
+				errs = append(errs, fmt.Errorf("error stopping %s: %w", r.name, r.err))
+			} else {
+				log.Debugf("%s stopped", r.name)
+			}
+		case <-ctx.Done():
+			return errors.Join(append(errs, fmt.Errorf("%d components of the %s stage didn't stop in time", pending, stage))...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// StopAndWait returns a StopFunc that calls stop and waits until done is closed.
+func StopAndWait(stop func(), done <-chan struct{}) StopFunc {
+	return func(ctx context.Context) error {
+		stop()
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownOrder(t *testing.T) {
+	c := NewCoordinator(context.Background(), Config{Timeout: types.NewDuration(5 * time.Second)})
+	var mu sync.Mutex
+	var stopped []string
+	stopFunc := func(name string) StopFunc {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			stopped = append(stopped, name)
+			return nil
+		}
+	}
+	// Registered out of order on purpose
+	c.Register(StageClaimTxManager, "claimtxman", stopFunc("claimtxman"))
+	c.Register(StageSync, "sync", stopFunc("sync"))
+	c.Register(StageIntake, "api", stopFunc("api"))
+
+	// The components are aborted only at the end of the shutdown
+	c.Register(StageSync, "sync-ctx", func(ctx context.Context) error {
+		return c.Context().Err()
+	})
+
+	require.NoError(t, c.Shutdown())
+	require.Equal(t, []string{"api", "sync", "claimtxman"}, stopped)
+	require.Error(t, c.Context().Err())
+}
+
+func TestShutdownErrors(t *testing.T) {
+	c := NewCoordinator(context.Background(), Config{Timeout: types.NewDuration(5 * time.Second)})
+	errStop := errors.New("stop error")
+	var claimTxManagerStopped bool
+	c.Register(StageSync, "sync", func(ctx context.Context) error { return errStop })
+	c.Register(StageClaimTxManager, "claimtxman", func(ctx context.Context) error {
+		claimTxManagerStopped = true
+		return nil
+	})
+
+	err := c.Shutdown()
+	require.ErrorIs(t, err, errStop)
+	// The errors don't prevent the next stages from stopping
+	require.True(t, claimTxManagerStopped)
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	c := NewCoordinator(context.Background(), Config{Timeout: types.NewDuration(100 * time.Millisecond)})
+	blocked := make(chan struct{})
+	defer close(blocked)
+	var claimTxManagerStopped bool
+	// A component ignoring the context can't block the shutdown
+	c.Register(StageSync, "stuck", func(ctx context.Context) error {
+		<-blocked
+		return nil
+	})
+	c.Register(StageSync, "sync", StopAndWait(func() {}, make(chan struct{})))
+	c.Register(StageClaimTxManager, "claimtxman", func(ctx context.Context) error {
+		claimTxManagerStopped = true
+		return nil
+	})
+
+	start := time.Now()
+	err := c.Shutdown()
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.False(t, claimTxManagerStopped)
+	require.Error(t, c.Context().Err())
+}
+
+func TestStopAndWait(t *testing.T) {
+	done := make(chan struct{})
+	stop := StopAndWait(func() { close(done) }, done)
+	require.NoError(t, stop(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stop = StopAndWait(func() {}, make(chan struct{}))
+	require.ErrorIs(t, stop(ctx), context.Canceled)
+}
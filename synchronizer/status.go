@@ -0,0 +1,73 @@
+package synchronizer
+
+import (
+	"sync"
+	"time"
+)
+
+// NetworkStatus is the live synchronization status of a network, as seen by its synchronizer.
+type NetworkStatus struct {
+	// ChainHead is the latest block number fetched from the network
+	ChainHead uint64
+	// Synced is true once the synchronizer has caught up with the chain head
+	Synced bool
+	// TrustedStateSynced is true when the last trusted state sync succeeded
+	TrustedStateSynced bool
+	// UpdatedAt is the last time the status was updated
+	UpdatedAt time.Time
+}
+
+// StatusTracker keeps the live status reported by the synchronizers of every network.
+// It is safe for concurrent use and a nil tracker ignores all the updates.
+type StatusTracker struct {
+	mu       sync.RWMutex
+	networks map[uint32]NetworkStatus
+}
+
+// NewStatusTracker creates an empty StatusTracker.
+func NewStatusTracker() *StatusTracker {
+	return &StatusTracker{
+		networks: make(map[uint32]NetworkStatus),
+	}
+}
+
+// GetNetworkStatus returns the status of a network and whether any synchronizer has reported it.
+func (t *StatusTracker) GetNetworkStatus(networkID uint32) (NetworkStatus, bool) {
+	if t == nil {
+		return NetworkStatus{}, false
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	status, ok := t.networks[networkID]
+	return status, ok
+}
+
+func (t *StatusTracker) setChainHead(networkID uint32, chainHead uint64) {
+	t.update(networkID, func(status *NetworkStatus) {
+		status.ChainHead = chainHead
+	})
+}
+
+func (t *StatusTracker) setSynced(networkID uint32, synced bool) {
+	t.update(networkID, func(status *NetworkStatus) {
+		status.Synced = synced
+	})
+}
+
+func (t *StatusTracker) setTrustedStateSynced(networkID uint32, synced bool) {
+	t.update(networkID, func(status *NetworkStatus) {
+		status.TrustedStateSynced = synced
+	})
+}
+
+func (t *StatusTracker) update(networkID uint32, f func(status *NetworkStatus)) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := t.networks[networkID]
+	f(&status)
+	status.UpdatedAt = time.Now()
+	t.networks[networkID] = status
+}
@@ -0,0 +1,32 @@
+package synchronizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusTracker(t *testing.T) {
+	tracker := NewStatusTracker()
+	_, ok := tracker.GetNetworkStatus(1)
+	require.False(t, ok)
+
+	tracker.setChainHead(1, 100)
+	tracker.setSynced(1, true)
+	tracker.setTrustedStateSynced(1, true)
+	status, ok := tracker.GetNetworkStatus(1)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), status.ChainHead)
+	require.True(t, status.Synced)
+	require.True(t, status.TrustedStateSynced)
+	require.False(t, status.UpdatedAt.IsZero())
+
+	_, ok = tracker.GetNetworkStatus(0)
+	require.False(t, ok)
+
+	// A nil tracker ignores the updates
+	var nilTracker *StatusTracker
+	nilTracker.setChainHead(1, 100)
+	_, ok = nilTracker.GetNetworkStatus(1)
+	require.False(t, ok)
+}
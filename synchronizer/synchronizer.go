@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/fiwallets/zkevm-bridge-service/etherman"
 	"github.com/fiwallets/zkevm-bridge-service/log"
+	"github.com/fiwallets/zkevm-bridge-service/metrics"
+	"github.com/fiwallets/zkevm-bridge-service/tracing"
 	"github.com/fiwallets/zkevm-bridge-service/utils/gerror"
 	"github.com/fiwallets/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
@@ -27,6 +30,8 @@ type ClientSynchronizer struct {
 	storage           storageInterface
 	ctx               context.Context
 	cancelCtx         context.CancelFunc
+	stopCh            chan struct{}
+	stopOnce          sync.Once
 	genBlockNumber    uint64
 	cfg               Config
 	networkID         uint32
@@ -38,6 +43,7 @@ type ClientSynchronizer struct {
 	l1RollupExitRoot  common.Hash
 	allNetworkIDs     []uint32
 	sovereignChain    bool
+	statusTracker     *StatusTracker
 }
 
 // NewSynchronizer creates and initializes an instance of Synchronizer
@@ -53,7 +59,8 @@ func NewSynchronizer(
 	chSynced chan uint32,
 	cfg Config,
 	allNetworkIDs []uint32,
-	sovereignChain bool) (Synchronizer, error) {
+	sovereignChain bool,
+	statusTracker *StatusTracker) (Synchronizer, error) {
 	ctx, cancel := context.WithCancel(parentCtx)
 	networkID := ethMan.GetNetworkID()
 	ger, err := storage.(storageInterface).GetLatestL1SyncedExitRoot(ctx, nil)
@@ -72,6 +79,7 @@ func NewSynchronizer(
 			etherMan:         ethMan,
 			ctx:              ctx,
 			cancelCtx:        cancel,
+			stopCh:           make(chan struct{}),
 			genBlockNumber:   genBlockNumber,
 			cfg:              cfg,
 			networkID:        networkID,
@@ -79,6 +87,7 @@ func NewSynchronizer(
 			chsExitRootEvent: chsExitRootEvent,
 			l1RollupExitRoot: ger.ExitRoots[1],
 			allNetworkIDs:    allNetworkIDs,
+			statusTracker:    statusTracker,
 		}, nil
 	}
 	return &ClientSynchronizer{
@@ -87,6 +96,7 @@ func NewSynchronizer(
 		etherMan:          ethMan,
 		ctx:               ctx,
 		cancelCtx:         cancel,
+		stopCh:            make(chan struct{}),
 		genBlockNumber:    genBlockNumber,
 		cfg:               cfg,
 		chSynced:          chSynced,
@@ -94,6 +104,7 @@ func NewSynchronizer(
 		chExitRootEventL2: chExitRootEventL2,
 		networkID:         networkID,
 		sovereignChain:    sovereignChain,
+		statusTracker:     statusTracker,
 	}, nil
 }
 
@@ -102,6 +113,7 @@ var waitDuration = time.Duration(0)
 // Sync function will read the last state synced and will continue from that point.
 // Sync() will read blockchain events to detect rollup updates
 func (s *ClientSynchronizer) Sync() error {
+	defer s.cancelCtx()
 	// If there is no lastEthereumBlock means that sync from the beginning is necessary. If not, it continues from the retrieved ethereum block
 	// Get the latest synced block. If there is no block on db, use genesis block
 	log.Infof("NetworkID: %d, Synchronization started", s.networkID)
@@ -120,11 +132,15 @@ func (s *ClientSynchronizer) Sync() error {
 		}
 	}
 	log.Debugf("NetworkID: %d, initial lastBlockSynced: %+v", s.networkID, lastBlockSynced)
+	metrics.SyncedBlock(s.networkID, lastBlockSynced.BlockNumber)
 	for {
 		select {
 		case <-s.ctx.Done():
 			log.Debugf("NetworkID: %d, synchronizer ctx done", s.networkID)
 			return nil
+		case <-s.stopCh:
+			log.Infof("NetworkID: %d, synchronizer stopped", s.networkID)
+			return nil
 		case <-time.After(waitDuration):
 			log.Debugf("NetworkID: %d, syncing...", s.networkID)
 			//Sync L1Blocks
@@ -141,7 +157,7 @@ func (s *ClientSynchronizer) Sync() error {
 				} else if err != nil {
 					log.Fatalf("networkID: %d, error getting lastBlockSynced to resume the synchronization... Error: ", s.networkID, err)
 				}
-				if s.ctx.Err() != nil {
+				if s.ctx.Err() != nil || s.isStopping() {
 					continue
 				}
 			}
@@ -153,10 +169,13 @@ func (s *ClientSynchronizer) Sync() error {
 					continue
 				}
 				lastKnownBlock := header.Number.Uint64()
+				s.statusTracker.setChainHead(s.networkID, lastKnownBlock)
+				metrics.ChainHead(s.networkID, lastKnownBlock)
 				if lastBlockSynced.BlockNumber == lastKnownBlock && !s.synced {
 					log.Infof("NetworkID %d Synced!", s.networkID)
 					waitDuration = s.cfg.SyncInterval.Duration
 					s.synced = true
+					s.statusTracker.setSynced(s.networkID, true)
 					s.chSynced <- s.networkID
 				}
 				if lastBlockSynced.BlockNumber > lastKnownBlock {
@@ -180,15 +199,27 @@ func (s *ClientSynchronizer) Sync() error {
 				if err != nil {
 					log.Errorf("networkID: %d, error getting current trusted state", s.networkID)
 				}
+				s.statusTracker.setTrustedStateSynced(s.networkID, err == nil)
 			}
 		}
 	}
 }
 
-// Stop function stops the synchronizer
+// Stop function stops the synchronizer. The block being processed is stored before Sync returns,
+// so the DB is left consistent. Cancel the parent context to abort the synchronization instead.
 func (s *ClientSynchronizer) Stop() {
-	log.Infof("NetworkID: %d, Stopping synchronizer and cancelling context", s.networkID)
-	s.cancelCtx()
+	log.Infof("NetworkID: %d, Stopping synchronizer", s.networkID)
+	s.stopOnce.Do(func() { close(s.stopCh) })
+}
+
+// isStopping returns true when the synchronizer has been asked to stop
+func (s *ClientSynchronizer) isStopping() bool {
+	select {
+	case <-s.stopCh:
+		return true
+	default:
+		return false
+	}
 }
 
 func (s *ClientSynchronizer) syncTrustedState() error {
@@ -224,6 +255,7 @@ func (s *ClientSynchronizer) syncTrustedState() error {
 		return err
 	}
 	if isUpdated {
+		metrics.GlobalExitRootsIngested(s.networkID, 1)
 		log.Debug("adding trusted ger to the channels. GER: ", lastGER)
 		s.chExitRootEventL2 <- ger
 	}
@@ -238,6 +270,8 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 		return lastBlockSynced, err
 	}
 	lastKnownBlock := header.Number
+	s.statusTracker.setChainHead(s.networkID, lastKnownBlock.Uint64())
+	metrics.ChainHead(s.networkID, lastKnownBlock.Uint64())
 	// This function will read events fromBlockNum to latestEthBlock. Check reorg to be sure that everything is ok.
 	block, err := s.checkReorg(lastBlockSynced, nil)
 	if err != nil {
@@ -269,6 +303,7 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 				log.Infof("NetworkID %d Synced!", s.networkID)
 				waitDuration = s.cfg.SyncInterval.Duration
 				s.synced = true
+				s.statusTracker.setSynced(s.networkID, true)
 				s.chSynced <- s.networkID
 			}
 		}
@@ -345,10 +380,11 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 			}
 		}
 
-		err = s.processBlockRange(blocks, order)
+		processed, err := s.processBlockRange(blocks, order)
 		if err != nil {
 			return lastBlockSynced, err
 		}
+		blocks = blocks[:processed]
 		if len(blocks) > 0 {
 			lastBlockSynced = &blocks[len(blocks)-1]
 			for i := range blocks {
@@ -361,6 +397,7 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 				log.Infof("NetworkID %d Synced!", s.networkID)
 				waitDuration = s.cfg.SyncInterval.Duration
 				s.synced = true
+				s.statusTracker.setSynced(s.networkID, true)
 				s.chSynced <- s.networkID
 			}
 			break
@@ -373,6 +410,10 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 			toBlock = toBlock + s.cfg.SyncChunkSize
 			log.Debugf("NetworkID: %d, synced!. New interval: from block %d, to block %d", s.networkID, fromBlock, toBlock)
 		}
+		if s.isStopping() {
+			log.Debugf("NetworkID: %d, synchronizer stopping, the next blocks are not requested", s.networkID)
+			break
+		}
 	}
 
 	return lastBlockSynced, nil
@@ -384,77 +425,22 @@ func removeBlockElement(slice []etherman.Block, s int) []etherman.Block {
 	return append(ret, slice[s+1:]...)
 }
 
-func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order map[common.Hash][]etherman.Order) error {
+// processBlockRange stores the blocks in order and returns the number of blocks stored. When the
+// synchronizer is stopping, the remaining blocks are skipped once the current one is stored.
+func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order map[common.Hash][]etherman.Order) (int, error) {
 	// New info has to be included into the db using the state
 	var isNewGer bool
+	processed := 0
 	for i := range blocks {
-		// Begin db transaction
-		dbTx, err := s.storage.BeginDBTransaction(s.ctx)
-		if err != nil {
-			log.Errorf("networkID: %d, error creating db transaction to store block. BlockNumber: %d. Error: %v",
-				s.networkID, blocks[i].BlockNumber, err)
-			return err
-		}
-		// Add block information
-		blocks[i].NetworkID = s.networkID
-		log.Infof("NetworkID: %d. Syncing block: %d", s.networkID, blocks[i].BlockNumber)
-		blockID, err := s.storage.AddBlock(s.ctx, &blocks[i], dbTx)
+		newGer, err := s.processBlock(&blocks[i], order[blocks[i].BlockHash])
 		if err != nil {
-			log.Errorf("networkID: %d, error storing block. BlockNumber: %d, error: %v", s.networkID, blocks[i].BlockNumber, err)
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, err: %s",
-					s.networkID, blocks[i].BlockNumber, rollbackErr, err.Error())
-				return rollbackErr
-			}
-			return err
+			return processed, err
 		}
-		for _, element := range order[blocks[i].BlockHash] {
-			switch element.Name {
-			case etherman.GlobalExitRootsOrder:
-				isNewGer = true
-				err = s.processGlobalExitRoot(blocks[i].GlobalExitRoots[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			case etherman.RemoveL2GEROrder:
-				err = s.processRemoveL2GlobalExitRoot(blocks[i].RemoveL2GER[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			case etherman.DepositsOrder:
-				err = s.processDeposit(blocks[i].Deposits[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			case etherman.ClaimsOrder:
-				err = s.processClaim(blocks[i].Claims[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			case etherman.TokensOrder:
-				err = s.processTokenWrapped(blocks[i].Tokens[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			case etherman.VerifyBatchOrder:
-				err = s.processVerifyBatch(blocks[i].VerifiedBatches[element.Pos], blockID, dbTx)
-				if err != nil {
-					return err
-				}
-			}
-		}
-		err = s.storage.Commit(s.ctx, dbTx)
-		if err != nil {
-			log.Errorf("networkID: %d, error committing state to store block. BlockNumber: %d, err: %v",
-				s.networkID, blocks[i].BlockNumber, err)
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, err: %s",
-					s.networkID, blocks[i].BlockNumber, rollbackErr, err.Error())
-				return rollbackErr
-			}
-			return err
+		processed++
+		isNewGer = isNewGer || newGer
+		if s.isStopping() && processed < len(blocks) {
+			log.Infof("NetworkID: %d, synchronizer stopping after storing block %d", s.networkID, blocks[i].BlockNumber)
+			break
 		}
 	}
 	if isNewGer {
@@ -462,17 +448,107 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 		ger, err := s.storage.GetLatestL1SyncedExitRoot(s.ctx, nil)
 		if err != nil {
 			log.Errorf("networkID: %d, error getting latest GER stored on database. Error: %v", s.networkID, err)
-			return err
+			return processed, err
 		}
 		if s.l1RollupExitRoot != ger.ExitRoots[1] {
 			log.Debugf("Updating ger: %+v", ger)
 			s.l1RollupExitRoot = ger.ExitRoots[1]
 			for _, ch := range s.chsExitRootEvent {
-				ch <- ger
+				select {
+				case ch <- ger:
+				case <-s.ctx.Done():
+					return processed, s.ctx.Err()
+				}
 			}
 		}
 	}
-	return nil
+	return processed, nil
+}
+
+// processBlock stores a block and its events in a single db transaction. It returns true
+// if a new global exit root has been stored.
+func (s *ClientSynchronizer) processBlock(block *etherman.Block, order []etherman.Order) (isNewGer bool, err error) {
+	depositCnts := make([]uint32, 0, len(block.Deposits))
+	for _, deposit := range block.Deposits {
+		depositCnts = append(depositCnts, deposit.DepositCount)
+	}
+	_, span := tracing.StartSpan(s.ctx, "synchronizer.processBlock", tracing.NetworkID(s.networkID),
+		tracing.BlockNumber(block.BlockNumber), tracing.BlockHash(block.BlockHash), tracing.DepositCounts(depositCnts))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	// Begin db transaction
+	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
+	if err != nil {
+		log.Errorf("networkID: %d, error creating db transaction to store block. BlockNumber: %d. Error: %v",
+			s.networkID, block.BlockNumber, err)
+		return false, err
+	}
+	// Add block information
+	block.NetworkID = s.networkID
+	log.Infof("NetworkID: %d. Syncing block: %d", s.networkID, block.BlockNumber)
+	blockID, err := s.storage.AddBlock(s.ctx, block, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing block. BlockNumber: %d, error: %v", s.networkID, block.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, err: %s",
+				s.networkID, block.BlockNumber, rollbackErr, err.Error())
+			return false, rollbackErr
+		}
+		return false, err
+	}
+	for _, element := range order {
+		switch element.Name {
+		case etherman.GlobalExitRootsOrder:
+			isNewGer = true
+			err = s.processGlobalExitRoot(block.GlobalExitRoots[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		case etherman.RemoveL2GEROrder:
+			err = s.processRemoveL2GlobalExitRoot(block.RemoveL2GER[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		case etherman.DepositsOrder:
+			err = s.processDeposit(block.Deposits[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		case etherman.ClaimsOrder:
+			err = s.processClaim(block.Claims[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		case etherman.TokensOrder:
+			err = s.processTokenWrapped(block.Tokens[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		case etherman.VerifyBatchOrder:
+			err = s.processVerifyBatch(block.VerifiedBatches[element.Pos], blockID, dbTx)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+	err = s.storage.Commit(s.ctx, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error committing state to store block. BlockNumber: %d, err: %v",
+			s.networkID, block.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, err: %s",
+				s.networkID, block.BlockNumber, rollbackErr, err.Error())
+			return false, rollbackErr
+		}
+		return false, err
+	}
+	metrics.SyncedBlock(s.networkID, block.BlockNumber)
+	metrics.DepositsIngested(s.networkID, len(block.Deposits))
+	metrics.ClaimsIngested(s.networkID, len(block.Claims))
+	metrics.GlobalExitRootsIngested(s.networkID, len(block.GlobalExitRoots))
+	return isNewGer, nil
 }
 
 // This function allows reset the state until an specific ethereum block
@@ -528,6 +604,8 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		}
 		return err
 	}
+	metrics.Reorg(s.networkID)
+	metrics.SyncedBlock(s.networkID, blockNumber)
 
 	return nil
 }
@@ -54,6 +54,7 @@ func NewSynchronizerTest(
 			etherMan:         ethMan,
 			ctx:              ctx,
 			cancelCtx:        cancel,
+			stopCh:           make(chan struct{}),
 			genBlockNumber:   genBlockNumber,
 			cfg:              cfg,
 			networkID:        networkID,
@@ -69,6 +70,7 @@ func NewSynchronizerTest(
 		etherMan:          ethMan,
 		ctx:               ctx,
 		cancelCtx:         cancel,
+		stopCh:            make(chan struct{}),
 		genBlockNumber:    genBlockNumber,
 		cfg:               cfg,
 		chSynced:          chSynced,
@@ -3,7 +3,9 @@ package operations
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/fiwallets/zkevm-bridge-service/bridgectrl"
 	"github.com/fiwallets/zkevm-bridge-service/db/pgstorage"
 	"github.com/fiwallets/zkevm-bridge-service/server"
@@ -43,7 +45,15 @@ func RunMockServer(dbType string, height uint8, networks []uint32) (*bridgectrl.
 		DefaultPageLimit: 25,     //nolint:gomnd
 		MaxPageLimit:     100,    //nolint:gomnd
 		BridgeVersion:    "v1",
+		Health: server.HealthConfig{
+			CheckInterval:               types.NewDuration(10 * time.Second), //nolint:gomnd
+			MaxSyncLag:                  100,                                 //nolint:gomnd
+			MaxSyncStatusAge:            types.NewDuration(5 * time.Minute),  //nolint:gomnd
+			MaxClaimTxManagerInactivity: types.NewDuration(5 * time.Minute),  //nolint:gomnd
+		},
 	}
 	bridgeService := server.NewBridgeService(cfg, btCfg.Height, networks, store)
-	return bt, store, server.RunServer(cfg, bridgeService)
+	healthChecker := server.NewHealthChecker(cfg.Health, networks, store, nil)
+	_, err = server.RunServer(cfg, bridgeService, healthChecker)
+	return bt, store, err
 }
@@ -0,0 +1,15 @@
+package tracing
+
+// Config represents the configuration of the tracing
+type Config struct {
+	// Enabled is the flag to export the spans. When disabled a no-op tracer is used
+	Enabled bool `mapstructure:"Enabled"`
+	// Endpoint is the address (host:port) of the OTLP gRPC collector
+	Endpoint string `mapstructure:"Endpoint"`
+	// Insecure disables the TLS of the connection to the collector
+	Insecure bool `mapstructure:"Insecure"`
+	// ServiceName is the name of the service reported in the spans
+	ServiceName string `mapstructure:"ServiceName"`
+	// SampleRatio is the ratio of traces sampled, from 0 to 1
+	SampleRatio float64 `mapstructure:"SampleRatio"`
+}
@@ -0,0 +1,165 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fiwallets/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// tracerName is the instrumentation scope of the spans created by the bridge
+	tracerName = "github.com/fiwallets/zkevm-bridge-service"
+
+	networkIDKey          = attribute.Key("bridge.network_id")
+	rollupIDKey           = attribute.Key("bridge.rollup_id")
+	blockNumberKey        = attribute.Key("bridge.block_number")
+	blockHashKey          = attribute.Key("bridge.block_hash")
+	globalExitRootKey     = attribute.Key("bridge.global_exit_root")
+	depositIDKey          = attribute.Key("bridge.deposit.id")
+	depositCntKey         = attribute.Key("bridge.deposit.deposit_cnt")
+	depositNetworkIDKey   = attribute.Key("bridge.deposit.network_id")
+	depositDestNetworkKey = attribute.Key("bridge.deposit.dest_net")
+	depositCntsKey        = attribute.Key("bridge.deposit.deposit_cnts")
+	depositIDsKey         = attribute.Key("bridge.deposit.ids")
+	groupIDKey            = attribute.Key("bridge.group_id")
+	txHashKey             = attribute.Key("bridge.tx_hash")
+	statusKey             = attribute.Key("bridge.status")
+)
+
+// ShutdownFunc flushes the pending spans and releases the exporter.
+type ShutdownFunc func(ctx context.Context) error
+
+// Init sets up the global tracer provider. When the tracing is disabled the default no-op
+// provider is kept, so the spans created by the bridge cost nothing and are never exported.
+func Init(ctx context.Context, cfg Config) (ShutdownFunc, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating the tracing resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span, child of the span in the context if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error, if any, in the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NetworkID is the network processed by the span.
+func NetworkID(networkID uint32) attribute.KeyValue {
+	return networkIDKey.Int64(int64(networkID))
+}
+
+// RollupID is the rollup whose claims are processed by the span.
+func RollupID(rollupID uint32) attribute.KeyValue {
+	return rollupIDKey.Int64(int64(rollupID))
+}
+
+// BlockNumber is the number of the block processed by the span.
+func BlockNumber(blockNumber uint64) attribute.KeyValue {
+	return blockNumberKey.Int64(int64(blockNumber))
+}
+
+// BlockHash is the hash of the block processed by the span.
+func BlockHash(hash common.Hash) attribute.KeyValue {
+	return blockHashKey.String(hash.String())
+}
+
+// GlobalExitRoot is the global exit root processed by the span.
+func GlobalExitRoot(ger common.Hash) attribute.KeyValue {
+	return globalExitRootKey.String(ger.String())
+}
+
+// Deposit returns the attributes that identify a deposit: its id in the DB, its deposit count and
+// its origin and destination networks.
+func Deposit(id uint64, depositCnt, networkID, destNetwork uint32) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		depositIDKey.Int64(int64(id)),
+		depositCntKey.Int64(int64(depositCnt)),
+		depositNetworkIDKey.Int64(int64(networkID)),
+		depositDestNetworkKey.Int64(int64(destNetwork)),
+	}
+}
+
+// DepositCount returns the attributes that identify a deposit by its deposit count in its
+// origin network.
+func DepositCount(depositCnt, networkID uint32) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		depositCntKey.Int64(int64(depositCnt)),
+		depositNetworkIDKey.Int64(int64(networkID)),
+	}
+}
+
+// DepositID is the DB id of the deposit processed by the span.
+func DepositID(id uint64) attribute.KeyValue {
+	return depositIDKey.Int64(int64(id))
+}
+
+// DepositCounts are the deposit counts of the deposits processed by the span.
+func DepositCounts(depositCnts []uint32) attribute.KeyValue {
+	values := make([]int64, len(depositCnts))
+	for i, depositCnt := range depositCnts {
+		values[i] = int64(depositCnt)
+	}
+	return depositCntsKey.Int64Slice(values)
+}
+
+// DepositIDs are the DB ids of the deposits processed by the span.
+func DepositIDs(ids []uint64) attribute.KeyValue {
+	values := make([]int64, len(ids))
+	for i, id := range ids {
+		values[i] = int64(id)
+	}
+	return depositIDsKey.Int64Slice(values)
+}
+
+// GroupID is the compressed claims group processed by the span.
+func GroupID(groupID uint64) attribute.KeyValue {
+	return groupIDKey.Int64(int64(groupID))
+}
+
+// TxHash is the hash of the tx sent by the span.
+func TxHash(hash common.Hash) attribute.KeyValue {
+	return txHashKey.String(hash.String())
+}
+
+// Status is the status of the monitored tx or group processed by the span.
+func Status(status string) attribute.KeyValue {
+	return statusKey.String(status)
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiwallets/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInitDisabled(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	shutdown, err := Init(context.Background(), Config{Enabled: false, Endpoint: "localhost:4317"})
+	require.NoError(t, err)
+	require.Equal(t, provider, otel.GetTracerProvider())
+	require.NoError(t, shutdown(context.Background()))
+
+	_, span := StartSpan(context.Background(), "noop")
+	require.False(t, span.SpanContext().IsValid())
+	EndSpan(span, nil)
+}
+
+func TestSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	ctx, parent := StartSpan(context.Background(), "parent", NetworkID(1), BlockNumber(100), BlockHash(common.HexToHash("0x01")))
+	_, child := StartSpan(ctx, "child", append(Deposit(7, 3, 0, 1), DepositCounts([]uint32{3, 4}), DepositIDs([]uint64{7, 8}))...)
+	EndSpan(child, errors.New("failed"))
+	EndSpan(parent, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	childSpan, parentSpan := spans[0], spans[1]
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, parentSpan.SpanContext.SpanID(), childSpan.Parent.SpanID())
+	require.Equal(t, codes.Error, childSpan.Status.Code)
+	require.Equal(t, "failed", childSpan.Status.Description)
+	require.Len(t, childSpan.Events, 1)
+	require.Contains(t, childSpan.Attributes, attribute.Int64("bridge.deposit.id", 7))
+	require.Contains(t, childSpan.Attributes, attribute.Int64("bridge.deposit.deposit_cnt", 3))
+	require.Contains(t, childSpan.Attributes, attribute.Int64("bridge.deposit.network_id", 0))
+	require.Contains(t, childSpan.Attributes, attribute.Int64("bridge.deposit.dest_net", 1))
+	require.Contains(t, childSpan.Attributes, attribute.Int64Slice("bridge.deposit.deposit_cnts", []int64{3, 4}))
+	require.Contains(t, childSpan.Attributes, attribute.Int64Slice("bridge.deposit.ids", []int64{7, 8}))
+
+	require.Equal(t, "parent", parentSpan.Name)
+	require.Equal(t, codes.Unset, parentSpan.Status.Code)
+	require.Contains(t, parentSpan.Attributes, attribute.Int64("bridge.network_id", 1))
+	require.Contains(t, parentSpan.Attributes, attribute.Int64("bridge.block_number", 100))
+	require.Contains(t, parentSpan.Attributes, attribute.String("bridge.block_hash", common.HexToHash("0x01").String()))
+}
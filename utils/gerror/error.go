@@ -15,4 +15,6 @@ var (
 	ErrDepositNotSynced = errors.New("not synchronized deposit")
 	// ErrNetworkNotRegister is used when the networkID is not registered in the bridge
 	ErrNetworkNotRegister = errors.New("not registered network")
+	// ErrLockNotAvailable is used when a lock is held by another process
+	ErrLockNotAvailable = errors.New("lock held by another process")
 )